@@ -0,0 +1,69 @@
+package assets
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Bindata adapts the functions generated by go-bindata for embedded assets
+// to an http.FileSystem. Set the fields to the generated functions of the
+// same names:
+//
+//	assets.Bindata{Asset: data.Asset, AssetInfo: data.AssetInfo, AssetDir: data.AssetDir}
+type Bindata struct {
+	Asset     func(name string) ([]byte, error)
+	AssetInfo func(name string) (os.FileInfo, error)
+	AssetDir  func(name string) ([]string, error)
+}
+
+// Open opens the named asset or asset folder for reading.
+func (b Bindata) Open(name string) (http.File, error) {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+
+	if byt, err := b.Asset(name); err == nil {
+		fi, err := b.AssetInfo(name)
+		if err != nil {
+			return nil, err
+		}
+		return &file{ReadSeeker: bytes.NewReader(byt), fi: baseInfo{fi}}, nil
+	}
+
+	children, err := b.AssetDir(name)
+	if err != nil {
+		return nil, os.ErrNotExist
+	}
+	sort.Strings(children)
+	d := &folder{fi: dirInfo(path.Base("/" + name))}
+	for _, child := range children {
+		if fi, err := b.AssetInfo(path.Join(name, child)); err == nil {
+			d.entries = append(d.entries, baseInfo{fi})
+		} else {
+			d.entries = append(d.entries, dirInfo(child))
+		}
+	}
+	return d, nil
+}
+
+// Stat returns the FileInfo of the named asset or asset folder without
+// reading the asset.
+func (b Bindata) Stat(name string) (os.FileInfo, error) {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if fi, err := b.AssetInfo(name); err == nil {
+		return baseInfo{fi}, nil
+	}
+	if _, err := b.AssetDir(name); err != nil {
+		return nil, os.ErrNotExist
+	}
+	return dirInfo(path.Base("/" + name)), nil
+}
+
+// baseInfo trims the folder from the names go-bindata reports in FileInfo
+type baseInfo struct {
+	os.FileInfo
+}
+
+func (fi baseInfo) Name() string { return path.Base(fi.FileInfo.Name()) }
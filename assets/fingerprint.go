@@ -0,0 +1,290 @@
+package assets
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"html/template"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// hashLen is the number of hex characters of the content hash inserted into
+// fingerprinted file names
+const hashLen = 12
+
+// Cache-Control header values for fingerprinted and plain asset URLs. A
+// fingerprinted URL changes whenever the content changes and can therefore
+// be cached forever while plain URLs must be revalidated on every use.
+const (
+	cacheImmutable  = "public, max-age=31536000, immutable"
+	cacheRevalidate = "no-cache"
+)
+
+// Fingerprinter serves the files in a file system under a URL prefix with
+// content hashed file names so that the WebView can cache them indefinitely
+// and still pick up changed files after an app upgrade. For example with
+// the prefix /res, the file app/components.js is served at both
+// /res/app/components.js and /res/app/components.0123456789ab.js where the
+// latter is served with an immutable Cache-Control header.
+//
+// References to files under the prefix in the src and href attributes of
+// served HTML and in the url() values of served CSS are rewritten to their
+// fingerprinted URLs. Templates can compute fingerprinted URLs using the
+// asset function in FuncMap().
+//
+// Content hashes are computed on first use and recomputed if the size or
+// modification time of a file changes. Responses carry an ETag of the
+// content served, which for HTML and CSS is the rewritten content, so that
+// revalidation picks up changes of the files they refer to.
+type Fingerprinter struct {
+	fs     http.FileSystem
+	prefix string
+	hashes *HashCache
+	inject string
+}
+
+// NewFingerprinter returns a Fingerprinter serving the files in fs under the
+// URL prefix. Register it on a route ending in /*filepath below the prefix:
+//
+//	fp := assets.NewFingerprinter(fs, "/res")
+//	srv.Router.Handle(contextrouter.GET, "/res/*filepath", fp)
+func NewFingerprinter(fs http.FileSystem, prefix string) *Fingerprinter {
+	return &Fingerprinter{
+		fs:     fs,
+		prefix: path.Clean("/" + prefix),
+		hashes: NewHashCache(fs),
+	}
+}
+
+// Hashes returns the cache of the content hashes of the files in the file
+// system. Pass it to images.New serving images from the same file system so
+// that each file is hashed once.
+func (f *Fingerprinter) Hashes() *HashCache {
+	return f.hashes
+}
+
+// ServeHTTP serves the file named by the filepath routing parameter.
+func (f *Fingerprinter) ServeHTTP(c context.Context, w http.ResponseWriter, r *http.Request) {
+	name := path.Clean("/" + c.Value("filepath").(string))
+
+	cache := cacheRevalidate
+	if _, err := statFile(f.fs, name); err != nil {
+		plain, hash, ok := splitHash(name)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		cur, err := f.hash(plain)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		// a stale fingerprint still gets the current content but mustn't
+		// be cached forever under the old name
+		if cur == hash {
+			cache = cacheImmutable
+		}
+		name = plain
+	}
+	w.Header().Set("Cache-Control", cache)
+	f.serve(w, r, name, path.Join(f.prefix, name))
+}
+
+// ServeFile serves the named file from the file system at the URL of the
+// request, rewriting references if it is an HTML or CSS file. This is useful
+// to serve an entry page like index.html at the root of the webapp.
+func (f *Fingerprinter) ServeFile(w http.ResponseWriter, r *http.Request, name string) {
+	w.Header().Set("Cache-Control", cacheRevalidate)
+	f.serve(w, r, path.Clean("/"+name), r.URL.Path)
+}
+
+// URL returns the fingerprinted URL for the URL path p. Paths outside the
+// prefix or not found in the file system are returned unchanged.
+func (f *Fingerprinter) URL(p string) string {
+	if ref, ok := f.rewrite(p, "/"); ok {
+		return ref
+	}
+	return p
+}
+
+// FuncMap returns template functions for use with html/template. The asset
+// function returns the fingerprinted URL of a path:
+//
+//	<script src="{{asset "/res/app/components.js"}}"></script>
+func (f *Fingerprinter) FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"asset": f.URL,
+	}
+}
+
+// serve writes the named file to w. HTML and CSS files are read fully and
+// have references rewritten relative to the URL they are served at.
+func (f *Fingerprinter) serve(w http.ResponseWriter, r *http.Request, name, docURL string) {
+	fl, err := f.fs.Open(name)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer fl.Close()
+
+	fi, err := fl.Stat()
+	if err != nil || fi.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+
+	if re := rewriters[strings.ToLower(path.Ext(name))]; re != nil {
+		b, err := ioutil.ReadAll(fl)
+		if err != nil {
+			log.Printf("Fingerprinter: could not read %s: %s", name, err)
+			http.Error(w, "", http.StatusInternalServerError)
+			return
+		}
+		b = f.rewriteAll(re, b, docURL)
+		if re == htmlRefs && f.inject != "" {
+			b = injectBeforeBody(b, f.inject)
+		}
+		// the rewritten content also changes with the files it refers to so
+		// the modification time of the file can't be used for revalidation
+		sum := sha256.Sum256(b)
+		w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])[:hashLen]+`"`)
+		http.ServeContent(w, r, fi.Name(), time.Time{}, bytes.NewReader(b))
+		return
+	}
+	if hash, err := f.hash(name); err == nil {
+		w.Header().Set("ETag", `"`+hash+`"`)
+	}
+	http.ServeContent(w, r, fi.Name(), fi.ModTime(), fl)
+}
+
+// rewriters holds the patterns matching references for each type of file
+// rewritten while serving. The reference including any quotes is matched
+// by the second submatch.
+var rewriters = map[string]*regexp.Regexp{
+	".html": htmlRefs,
+	".htm":  htmlRefs,
+	".css":  cssRefs,
+}
+
+var (
+	htmlRefs = regexp.MustCompile(`(?i)(\s(?:src|href)\s*=\s*)("[^"]*"|'[^']*')`)
+	cssRefs  = regexp.MustCompile(`(url\(\s*)("[^"]*"|'[^']*'|[^)'"\s]+)`)
+)
+
+func (f *Fingerprinter) rewriteAll(re *regexp.Regexp, b []byte, docURL string) []byte {
+	return re.ReplaceAllFunc(b, func(m []byte) []byte {
+		sub := re.FindSubmatch(m)
+		ref := string(sub[2])
+		quote := ""
+		if ref[0] == '"' || ref[0] == '\'' {
+			quote, ref = ref[:1], ref[1:len(ref)-1]
+		}
+		newref, ok := f.rewrite(ref, docURL)
+		if !ok {
+			return m
+		}
+		return []byte(string(sub[1]) + quote + newref + quote)
+	})
+}
+
+// injectBeforeBody inserts snippet before the closing body tag of the HTML
+// page b or appends it if there is none
+func injectBeforeBody(b []byte, snippet string) []byte {
+	i := bytes.LastIndex(bytes.ToLower(b), []byte("</body>"))
+	if i < 0 {
+		return append(b, snippet...)
+	}
+	ret := make([]byte, 0, len(b)+len(snippet))
+	ret = append(ret, b[:i]...)
+	ret = append(ret, snippet...)
+	return append(ret, b[i:]...)
+}
+
+// rewrite returns the fingerprinted form of the reference ref found in the
+// document served at docURL. Relative references stay relative.
+func (f *Fingerprinter) rewrite(ref, docURL string) (string, bool) {
+	if ref == "" || strings.HasPrefix(ref, "//") || strings.HasPrefix(ref, "#") || strings.Contains(ref, ":") {
+		return "", false
+	}
+
+	refpath, suffix := ref, ""
+	if i := strings.IndexAny(ref, "?#"); i >= 0 {
+		refpath, suffix = ref[:i], ref[i:]
+	}
+
+	abs := refpath
+	if !strings.HasPrefix(abs, "/") {
+		abs = path.Join(path.Dir(docURL), abs)
+	}
+	name, ok := f.name(abs)
+	if !ok {
+		return "", false
+	}
+
+	hash, err := f.hash(name)
+	if err != nil {
+		return "", false
+	}
+	return joinHash(refpath, hash) + suffix, true
+}
+
+// name returns the name in the file system of the file served at the URL
+// path p and false if p is outside the prefix
+func (f *Fingerprinter) name(p string) (string, bool) {
+	if f.prefix == "/" {
+		return p, true
+	}
+	if !strings.HasPrefix(p, f.prefix+"/") {
+		return "", false
+	}
+	return strings.TrimPrefix(p, f.prefix), true
+}
+
+// hash returns the fingerprint of the named file
+func (f *Fingerprinter) hash(name string) (string, error) {
+	hash, err := f.hashes.Hash(name)
+	if err != nil {
+		return "", err
+	}
+	return hash[:hashLen], nil
+}
+
+// joinHash inserts hash into the file name of p before the extension
+func joinHash(p, hash string) string {
+	ext := path.Ext(p)
+	return p[:len(p)-len(ext)] + "." + hash + ext
+}
+
+// splitHash reverses joinHash returning the plain path and the hash
+func splitHash(p string) (string, string, bool) {
+	ext := path.Ext(p)
+	base := p[:len(p)-len(ext)]
+	if isHash(ext) {
+		return base, ext[1:], true
+	}
+	hash := path.Ext(base)
+	if isHash(hash) {
+		return base[:len(base)-len(hash)] + ext, hash[1:], true
+	}
+	return "", "", false
+}
+
+func isHash(ext string) bool {
+	if len(ext) != hashLen+1 {
+		return false
+	}
+	for _, c := range ext[1:] {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
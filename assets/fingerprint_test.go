@@ -0,0 +1,287 @@
+package assets
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"html/template"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/srinathh/mobilehtml5app/contextrouter"
+	"golang.org/x/net/context"
+)
+
+var fingerprintFiles = map[string]string{
+	"index.html":     `<html><head><link href="/res/app/styles.css" rel="stylesheet"/><script src='/res/app/app.js'></script></head><body><a href="/items">Items</a><img src="https://example.com/x.png"></body></html>`,
+	"app/app.js":     `console.log("hello")`,
+	"app/styles.css": `body{background:url(../img/bg.jpg)} @font-face{src:url("../img/font.eot?#iefix")}`,
+	"img/bg.jpg":     `not really a jpeg`,
+	"img/font.eot":   `not really a font`,
+}
+
+func contentHash(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:])[:hashLen]
+}
+
+func writeTestDir(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "assets_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, body := range files {
+		fpath := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(fpath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(fpath, []byte(body), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func get(t *testing.T, url string) (*http.Response, string) {
+	res, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("could not fetch from %s: %s", url, err)
+	}
+	defer res.Body.Close()
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("could not read response body from %s: %s", url, err)
+	}
+	return res, string(b)
+}
+
+func TestFingerprinter(t *testing.T) {
+	dir := writeTestDir(t, fingerprintFiles)
+	defer os.RemoveAll(dir)
+
+	fp := NewFingerprinter(http.Dir(dir), "/res")
+	router := contextrouter.New()
+	router.Handle(contextrouter.GET, "/res/*filepath", fp)
+	router.HandleFunc(contextrouter.GET, "/", func(_ context.Context, w http.ResponseWriter, r *http.Request) {
+		fp.ServeFile(w, r, "index.html")
+	})
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	jsHash := contentHash(fingerprintFiles["app/app.js"])
+	cssHash := contentHash(fingerprintFiles["app/styles.css"])
+
+	res, got := get(t, ts.URL+"/")
+	for _, want := range []string{
+		`href="/res/app/styles.` + cssHash + `.css"`,
+		`src='/res/app/app.` + jsHash + `.js'`,
+		`href="/items"`,
+		`src="https://example.com/x.png"`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("index.html: want %s in %s", want, got)
+		}
+	}
+	if cc := res.Header.Get("Cache-Control"); cc != cacheRevalidate {
+		t.Errorf("index.html: want Cache-Control %s got %s", cacheRevalidate, cc)
+	}
+
+	res, got = get(t, ts.URL+"/res/app/styles."+cssHash+".css")
+	for _, want := range []string{
+		`url(../img/bg.` + contentHash(fingerprintFiles["img/bg.jpg"]) + `.jpg)`,
+		`url("../img/font.` + contentHash(fingerprintFiles["img/font.eot"]) + `.eot?#iefix")`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("styles.css: want %s in %s", want, got)
+		}
+	}
+	if cc := res.Header.Get("Cache-Control"); cc != cacheImmutable {
+		t.Errorf("styles.css: want Cache-Control %s got %s", cacheImmutable, cc)
+	}
+
+	tests := []struct {
+		path  string
+		code  int
+		cache string
+	}{
+		{"/res/app/app." + jsHash + ".js", http.StatusOK, cacheImmutable},
+		{"/res/app/app.js", http.StatusOK, cacheRevalidate},
+		{"/res/app/app.0123456789ab.js", http.StatusOK, cacheRevalidate},
+		{"/res/app/missing.0123456789ab.js", http.StatusNotFound, ""},
+		{"/res/app", http.StatusNotFound, ""},
+	}
+	for _, test := range tests {
+		res, got := get(t, ts.URL+test.path)
+		if res.StatusCode != test.code {
+			t.Errorf("%s: want status %d got %d", test.path, test.code, res.StatusCode)
+			continue
+		}
+		if test.code != http.StatusOK {
+			continue
+		}
+		if got != fingerprintFiles["app/app.js"] {
+			t.Errorf("%s: want: %s got: %s", test.path, fingerprintFiles["app/app.js"], got)
+		}
+		if cc := res.Header.Get("Cache-Control"); cc != test.cache {
+			t.Errorf("%s: want Cache-Control %s got %s", test.path, test.cache, cc)
+		}
+	}
+}
+
+// revalidate fetches url with If-None-Match set to etag
+func revalidate(t *testing.T, url, etag string) (*http.Response, string) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("If-None-Match", etag)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("could not fetch from %s: %s", url, err)
+	}
+	defer res.Body.Close()
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("could not read response body from %s: %s", url, err)
+	}
+	return res, string(b)
+}
+
+func TestFingerprinterRevalidate(t *testing.T) {
+	dir := writeTestDir(t, fingerprintFiles)
+	defer os.RemoveAll(dir)
+
+	fp := NewFingerprinter(http.Dir(dir), "/res")
+	router := contextrouter.New()
+	router.Handle(contextrouter.GET, "/res/*filepath", fp)
+	router.HandleFunc(contextrouter.GET, "/", func(_ context.Context, w http.ResponseWriter, r *http.Request) {
+		fp.ServeFile(w, r, "index.html")
+	})
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	page, _ := get(t, ts.URL+"/")
+	js, _ := get(t, ts.URL+"/res/app/app.js")
+	for path, etag := range map[string]string{"/": page.Header.Get("ETag"), "/res/app/app.js": js.Header.Get("ETag")} {
+		if etag == "" {
+			t.Fatalf("%s: no ETag", path)
+		}
+		if res, _ := revalidate(t, ts.URL+path, etag); res.StatusCode != http.StatusNotModified {
+			t.Errorf("%s: want status %d got %d", path, http.StatusNotModified, res.StatusCode)
+		}
+	}
+
+	// index.html itself is unchanged but refers to the changed script
+	changed := `console.log("changed")`
+	if err := ioutil.WriteFile(filepath.Join(dir, "app", "app.js"), []byte(changed), 0644); err != nil {
+		t.Fatal(err)
+	}
+	res, got := revalidate(t, ts.URL+"/", page.Header.Get("ETag"))
+	if want := "/res/app/app." + contentHash(changed) + ".js"; res.StatusCode != http.StatusOK || !strings.Contains(got, want) {
+		t.Errorf("index.html: want status 200 and %s got %d %s", want, res.StatusCode, got)
+	}
+	if res, got := revalidate(t, ts.URL+"/res/app/app.js", js.Header.Get("ETag")); res.StatusCode != http.StatusOK || got != changed {
+		t.Errorf("app.js: want status 200 and %s got %d %s", changed, res.StatusCode, got)
+	}
+}
+
+func TestFingerprinterURL(t *testing.T) {
+	dir := writeTestDir(t, fingerprintFiles)
+	defer os.RemoveAll(dir)
+
+	fp := NewFingerprinter(http.Dir(dir), "res")
+	want := "/res/app/app." + contentHash(fingerprintFiles["app/app.js"]) + ".js"
+	if got := fp.URL("/res/app/app.js"); got != want {
+		t.Errorf("want %s got %s", want, got)
+	}
+	if got := fp.URL("/res/app/missing.js"); got != "/res/app/missing.js" {
+		t.Errorf("missing file: want unchanged path got %s", got)
+	}
+
+	tmpl := template.Must(template.New("t").Funcs(fp.FuncMap()).Parse(`{{asset "/res/app/app.js"}}`))
+	buf := bytes.Buffer{}
+	if err := tmpl.Execute(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != want {
+		t.Errorf("template: want %s got %s", want, buf.String())
+	}
+
+	// changed content must produce a new fingerprint
+	changed := `console.log("changed")`
+	if err := ioutil.WriteFile(filepath.Join(dir, "app", "app.js"), []byte(changed), 0644); err != nil {
+		t.Fatal(err)
+	}
+	want = "/res/app/app." + contentHash(changed) + ".js"
+	if got := fp.URL("/res/app/app.js"); got != want {
+		t.Errorf("after change: want %s got %s", want, got)
+	}
+
+	root := NewFingerprinter(http.Dir(dir), "/")
+	if got, want := root.URL("/app/app.js"), "/app/app."+contentHash(changed)+".js"; got != want {
+		t.Errorf("root prefix: want %s got %s", want, got)
+	}
+}
+
+// statDir is a StatFS counting the files opened
+type statDir struct {
+	http.Dir
+	opened int
+}
+
+func (d *statDir) Open(name string) (http.File, error) {
+	d.opened++
+	return d.Dir.Open(name)
+}
+
+func (d *statDir) Stat(name string) (os.FileInfo, error) {
+	return os.Stat(filepath.Join(string(d.Dir), filepath.FromSlash(name)))
+}
+
+func TestHashCacheStat(t *testing.T) {
+	dir := writeTestDir(t, fingerprintFiles)
+	defer os.RemoveAll(dir)
+
+	fs := &statDir{Dir: http.Dir(dir)}
+	hc := NewHashCache(fs)
+	for i := 0; i < 3; i++ {
+		hash, err := hc.Hash("/app/app.js")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hash[:hashLen] != contentHash(fingerprintFiles["app/app.js"]) {
+			t.Errorf("wrong hash %s", hash)
+		}
+	}
+	if fs.opened != 1 {
+		t.Errorf("want the file opened once to hash it got %d opens", fs.opened)
+	}
+	if _, err := hc.Hash("/app"); err == nil {
+		t.Errorf("want an error hashing a folder")
+	}
+}
+
+func TestSplitHash(t *testing.T) {
+	tests := []struct {
+		in, plain, hash string
+	}{
+		{"/app/app.0123456789ab.js", "/app/app.js", "0123456789ab"},
+		{"/jquery/jquery.min.0123456789ab.js", "/jquery/jquery.min.js", "0123456789ab"},
+		{"/LICENSE.0123456789ab", "/LICENSE", "0123456789ab"},
+		{"/jquery/jquery.min.js", "", ""},
+	}
+	for _, test := range tests {
+		plain, hash, _ := splitHash(test.in)
+		if plain != test.plain || hash != test.hash {
+			t.Errorf("%s: want %s %s got %s %s", test.in, test.plain, test.hash, plain, hash)
+		}
+		if test.hash != "" && joinHash(plain, hash) != test.in {
+			t.Errorf("%s: joinHash gave %s", test.in, joinHash(plain, hash))
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package assets
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// HashCache caches the content hashes of the files in a file system. Hashes
+// are computed on first use and recomputed if the size or modification time
+// of a file changes. The Fingerprinter and the images package both derive
+// URLs or ETags from file contents and can share one cache for the same file
+// system through Fingerprinter.Hashes() so that each file is hashed once.
+//
+// Files are only opened to be hashed. File systems that can tell the size
+// and modification time of a file without opening it, like Zip and Bindata
+// which would otherwise decompress the file, implement the Stat method of
+// StatFS.
+type HashCache struct {
+	fs     http.FileSystem
+	hashes map[string]fileHash
+	sync.Mutex
+}
+
+// fileHash is a cached content hash along with the file attributes it was
+// computed for
+type fileHash struct {
+	size    int64
+	modTime time.Time
+	hash    string
+}
+
+// StatFS is implemented by file systems that return the FileInfo of a file
+// more cheaply than opening it
+type StatFS interface {
+	http.FileSystem
+	Stat(name string) (os.FileInfo, error)
+}
+
+// NewHashCache returns a HashCache for the files in fs.
+func NewHashCache(fs http.FileSystem) *HashCache {
+	return &HashCache{
+		fs:     fs,
+		hashes: make(map[string]fileHash),
+	}
+}
+
+// Hash returns the hex encoded SHA-256 hash of the content of the named file,
+// computing it if the file is not in the cache or has changed since it was
+// cached.
+func (hc *HashCache) Hash(name string) (string, error) {
+	fi, err := statFile(hc.fs, name)
+	if err != nil {
+		return "", err
+	}
+
+	hc.Lock()
+	fh, ok := hc.hashes[name]
+	hc.Unlock()
+	if ok && fh.size == fi.Size() && fh.modTime.Equal(fi.ModTime()) {
+		return fh.hash, nil
+	}
+
+	fl, err := hc.fs.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer fl.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, fl); err != nil {
+		return "", fmt.Errorf("could not hash %s: %s", name, err)
+	}
+	fh = fileHash{
+		size:    fi.Size(),
+		modTime: fi.ModTime(),
+		hash:    hex.EncodeToString(h.Sum(nil)),
+	}
+	hc.Lock()
+	hc.hashes[name] = fh
+	hc.Unlock()
+	return fh.hash, nil
+}
+
+// statFile returns the FileInfo of the named file and an error if it is
+// missing or is a folder
+func statFile(fs http.FileSystem, name string) (os.FileInfo, error) {
+	var fi os.FileInfo
+	if sfs, ok := fs.(StatFS); ok {
+		var err error
+		if fi, err = sfs.Stat(name); err != nil {
+			return nil, err
+		}
+	} else {
+		fl, err := fs.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer fl.Close()
+		if fi, err = fl.Stat(); err != nil {
+			return nil, err
+		}
+	}
+	if fi.IsDir() {
+		return nil, fmt.Errorf("%s is a directory", name)
+	}
+	return fi, nil
+}
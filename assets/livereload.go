@@ -0,0 +1,146 @@
+package assets
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// LiveReloadPath is the route at which Mount registers the LiveReload event
+// stream in dev mode
+const LiveReloadPath = "/_livereload"
+
+// liveReloadScript is injected into HTML pages served in dev mode to reload
+// the page when the LiveReload stream reports a change
+const liveReloadScript = `<script>new EventSource("` + LiveReloadPath + `").addEventListener("reload", function(){ location.reload(); });</script>`
+
+// LiveReload watches a folder on disk and notifies connected pages of
+// changes with a reload event over a Server-Sent Events stream. The folder
+// is polled for changes in file names, sizes and modification times only
+// while at least one page is connected. Streams end when the root context of
+// the server is closed on Stop().
+type LiveReload struct {
+	dir      string
+	interval time.Duration
+	clients  map[chan struct{}]bool
+	stop     chan struct{}
+	sync.Mutex
+}
+
+// NewLiveReload returns a LiveReload watching dir for changes at every
+// interval.
+func NewLiveReload(dir string, interval time.Duration) *LiveReload {
+	return &LiveReload{
+		dir:      dir,
+		interval: interval,
+		clients:  make(map[chan struct{}]bool),
+	}
+}
+
+// ServeHTTP streams reload events to the client until the client goes away
+// or the server is stopped.
+func (l *LiveReload) ServeHTTP(c context.Context, w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	var gone <-chan bool
+	if cn, ok := w.(http.CloseNotifier); ok {
+		gone = cn.CloseNotify()
+	}
+
+	ch := l.subscribe()
+	defer l.unsubscribe(ch)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	fmt.Fprint(w, "retry: 1000\n\n")
+	flusher.Flush()
+
+	for {
+		select {
+		case <-c.Done():
+			return
+		case <-gone:
+			return
+		case <-ch:
+			fmt.Fprint(w, "event: reload\ndata: {}\n\n")
+			flusher.Flush()
+		}
+	}
+}
+
+// subscribe registers a new client, starting to watch the folder if it is
+// the first one
+func (l *LiveReload) subscribe() chan struct{} {
+	ch := make(chan struct{}, 1)
+	l.Lock()
+	defer l.Unlock()
+	l.clients[ch] = true
+	if l.stop == nil {
+		l.stop = make(chan struct{})
+		go l.watch(l.stop, l.scan())
+	}
+	return ch
+}
+
+// unsubscribe removes a client, stopping the watch if it was the last one
+func (l *LiveReload) unsubscribe(ch chan struct{}) {
+	l.Lock()
+	defer l.Unlock()
+	delete(l.clients, ch)
+	if len(l.clients) == 0 && l.stop != nil {
+		close(l.stop)
+		l.stop = nil
+	}
+}
+
+// watch polls the folder until stop is closed and notifies all clients when
+// the folder differs from the last scan
+func (l *LiveReload) watch(stop chan struct{}, last uint64) {
+	t := time.NewTicker(l.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+			if cur := l.scan(); cur != last {
+				last = cur
+				l.notify()
+			}
+		}
+	}
+}
+
+func (l *LiveReload) notify() {
+	l.Lock()
+	defer l.Unlock()
+	for ch := range l.clients {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// scan returns a signature of the names, sizes and modification times of
+// all the files in the folder
+func (l *LiveReload) scan() uint64 {
+	h := fnv.New64a()
+	filepath.Walk(l.dir, func(fpath string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		fmt.Fprintf(h, "%s %d %d\n", fpath, fi.Size(), fi.ModTime().UnixNano())
+		return nil
+	})
+	return h.Sum64()
+}
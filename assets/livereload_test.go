@@ -0,0 +1,109 @@
+package assets
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srinathh/mobilehtml5app/contextrouter"
+	"golang.org/x/net/context"
+)
+
+func TestMountDev(t *testing.T) {
+	dir := writeTestDir(t, fingerprintFiles)
+	defer os.RemoveAll(dir)
+
+	router := contextrouter.New()
+	fp := Mount(router, "/res", http.Dir("/nonexistent"), dir)
+	router.HandleFunc(contextrouter.GET, "/", func(_ context.Context, w http.ResponseWriter, r *http.Request) {
+		fp.ServeFile(w, r, "index.html")
+	})
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	page, got := get(t, ts.URL+"/")
+	if !strings.Contains(got, liveReloadScript+"</body>") {
+		t.Errorf("live reload script not injected in %s", got)
+	}
+	if res, got := get(t, ts.URL+"/res/app/app.js"); res.StatusCode != http.StatusOK || got != fingerprintFiles["app/app.js"] {
+		t.Errorf("want %s from devDir got %d %s", fingerprintFiles["app/app.js"], res.StatusCode, got)
+	}
+
+	res, err := http.Get(ts.URL + LiveReloadPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	events := make(chan string)
+	go func() {
+		s := bufio.NewScanner(res.Body)
+		for s.Scan() {
+			if strings.HasPrefix(s.Text(), "event:") {
+				events <- s.Text()
+			}
+		}
+		close(events)
+	}()
+
+	// give the watcher a moment to take its first snapshot before changing
+	time.Sleep(liveReloadInterval)
+	changed := `console.log("changed")`
+	if err := ioutil.WriteFile(filepath.Join(dir, "app", "app.js"), []byte(changed), 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case ev := <-events:
+		if ev != "event: reload" {
+			t.Errorf("want reload event got %s", ev)
+		}
+	case <-time.After(liveReloadInterval * 6):
+		t.Fatal("no reload event after changing a file")
+	}
+
+	// the reloaded page revalidates its cached copy and must refer to the
+	// changed script
+	reloaded, got := revalidate(t, ts.URL+"/", page.Header.Get("ETag"))
+	if want := "/res/app/app." + contentHash(changed) + ".js"; reloaded.StatusCode != http.StatusOK || !strings.Contains(got, want) {
+		t.Errorf("reloaded page: want status 200 and %s got %d %s", want, reloaded.StatusCode, got)
+	}
+
+	// stopping the router must end the stream
+	done := make(chan struct{})
+	go func() {
+		router.Stop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("router did not stop with an open live reload stream")
+	}
+	for range events {
+	}
+}
+
+func TestMountRelease(t *testing.T) {
+	dir := writeTestDir(t, fingerprintFiles)
+	defer os.RemoveAll(dir)
+
+	router := contextrouter.New()
+	fp := Mount(router, "/res", http.Dir(dir), "")
+	router.HandleFunc(contextrouter.GET, "/", func(_ context.Context, w http.ResponseWriter, r *http.Request) {
+		fp.ServeFile(w, r, "index.html")
+	})
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	if _, got := get(t, ts.URL+"/"); strings.Contains(got, LiveReloadPath) {
+		t.Errorf("live reload script injected in release mode: %s", got)
+	}
+	if res, _ := get(t, ts.URL+LiveReloadPath); res.StatusCode != http.StatusNotFound {
+		t.Errorf("live reload stream served in release mode: %d", res.StatusCode)
+	}
+}
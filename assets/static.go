@@ -0,0 +1,39 @@
+package assets
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/srinathh/mobilehtml5app/contextrouter"
+)
+
+// liveReloadInterval is how often the dev mode folder is polled for changes
+const liveReloadInterval = time.Millisecond * 500
+
+// Mount registers a Fingerprinter serving the frontend assets of the webapp
+// at prefix on router and returns it. Release builds should pass an empty
+// devDir to serve the assets embedded in or shipped with the app in fs.
+//
+// During development pass the folder on disk holding the same assets as
+// devDir. The assets are then read from disk instead of fs and pages served
+// by the Fingerprinter reload themselves whenever a file in devDir changes,
+// using a script injected into the page that listens for events from a
+// LiveReload stream at LiveReloadPath. All URLs stay the same in both modes
+// so switching between them needs no other change. A common setup is to set
+// devDir in a file built only with a dev build tag:
+//
+//	fp := assets.Mount(srv.Router, "/res", assets.Bindata{...}, devDir)
+func Mount(router *contextrouter.ContextRouter, prefix string, fs http.FileSystem, devDir string) *Fingerprinter {
+	if devDir != "" {
+		fs = http.Dir(devDir)
+	}
+	fp := NewFingerprinter(fs, prefix)
+	router.Handle(contextrouter.GET, strings.TrimSuffix(fp.prefix, "/")+"/*filepath", fp)
+
+	if devDir != "" {
+		fp.inject = liveReloadScript
+		router.Handle(contextrouter.GET, LiveReloadPath, NewLiveReload(devDir, liveReloadInterval))
+	}
+	return fp
+}
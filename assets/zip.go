@@ -0,0 +1,233 @@
+// Package assets provides file systems and handlers to serve the HTML5
+// frontend of a webapp from the Go backend.
+//
+// Zip serves files straight out of a zip archive such as the APK of an
+// Android app so that frontend assets need not be compiled into the Go
+// library with go-bindata. Any type satisfying http.FileSystem, like
+// http.Dir or Zip, can be registered with ContextRouter.ServeFiles().
+//
+// Fingerprinter serves assets from any such file system under content hashed
+// URLs that the WebView can cache indefinitely, rewriting the references in
+// HTML and CSS files as they are served. Bindata adapts assets embedded with
+// go-bindata to an http.FileSystem.
+package assets
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Zip is an http.FileSystem serving the entries of a zip archive. The
+// archive is indexed once when it is opened. Entries stored without
+// compression are read directly from the archive while deflated entries are
+// decompressed into memory when opened so that both support seeking and
+// therefore Range requests.
+type Zip struct {
+	r     io.ReaderAt
+	c     io.Closer
+	files map[string]*zip.File
+	dirs  map[string][]string
+}
+
+// OpenZip opens the zip archive at fpath and indexes the entries under the
+// folder prefix. For an Android app, pass the path of the APK as handed over
+// by the native code (Context.getPackageCodePath()) and "assets" as prefix to
+// serve the contents of the assets/ directory. Call Close() when done.
+func OpenZip(fpath, prefix string) (*Zip, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, fmt.Errorf("could not open zip archive %s: %s", fpath, err)
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("could not stat zip archive %s: %s", fpath, err)
+	}
+	z, err := NewZip(f, fi.Size(), prefix)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	z.c = f
+	return z, nil
+}
+
+// NewZip indexes the zip archive of the given size read from r and returns
+// a Zip serving the entries under the folder prefix.
+func NewZip(r io.ReaderAt, size int64, prefix string) (*Zip, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("could not read zip archive: %s", err)
+	}
+
+	prefix = strings.Trim(prefix, "/")
+	if prefix != "" {
+		prefix = prefix + "/"
+	}
+
+	z := &Zip{
+		r:     r,
+		files: make(map[string]*zip.File),
+		dirs:  map[string][]string{"": nil},
+	}
+	for _, f := range zr.File {
+		if !strings.HasPrefix(f.Name, prefix) || strings.HasSuffix(f.Name, "/") {
+			continue
+		}
+		name := strings.TrimPrefix(f.Name, prefix)
+		z.files[name] = f
+		z.addDir(name)
+	}
+	for _, children := range z.dirs {
+		sort.Strings(children)
+	}
+	return z, nil
+}
+
+// addDir records name as a child of its parent folder, creating entries for
+// all the parent folders as needed since zip archives need not contain them.
+func (z *Zip) addDir(name string) {
+	for name != "" {
+		dir := path.Dir(name)
+		if dir == "." {
+			dir = ""
+		}
+		_, seen := z.dirs[dir]
+		z.dirs[dir] = append(z.dirs[dir], path.Base(name))
+		if seen {
+			return
+		}
+		name = dir
+	}
+}
+
+// Open opens the named file or folder for reading.
+func (z *Zip) Open(name string) (http.File, error) {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+
+	if f, ok := z.files[name]; ok {
+		rs, err := z.content(f)
+		if err != nil {
+			return nil, err
+		}
+		return &file{ReadSeeker: rs, fi: f.FileInfo()}, nil
+	}
+
+	if children, ok := z.dirs[name]; ok {
+		d := &folder{fi: dirInfo(path.Base("/" + name))}
+		for _, child := range children {
+			if f, ok := z.files[path.Join(name, child)]; ok {
+				d.entries = append(d.entries, f.FileInfo())
+			} else {
+				d.entries = append(d.entries, dirInfo(child))
+			}
+		}
+		return d, nil
+	}
+	return nil, os.ErrNotExist
+}
+
+// Stat returns the FileInfo of the named file or folder from the index of
+// the archive without decompressing it.
+func (z *Zip) Stat(name string) (os.FileInfo, error) {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if f, ok := z.files[name]; ok {
+		return f.FileInfo(), nil
+	}
+	if _, ok := z.dirs[name]; ok {
+		return dirInfo(path.Base("/" + name)), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+// content returns a seekable reader for the uncompressed contents of f.
+func (z *Zip) content(f *zip.File) (io.ReadSeeker, error) {
+	if f.Method == zip.Store {
+		offset, err := f.DataOffset()
+		if err != nil {
+			return nil, fmt.Errorf("could not locate %s in zip archive: %s", f.Name, err)
+		}
+		return io.NewSectionReader(z.r, offset, int64(f.UncompressedSize64)), nil
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("could not open %s in zip archive: %s", f.Name, err)
+	}
+	defer rc.Close()
+	b, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress %s in zip archive: %s", f.Name, err)
+	}
+	return bytes.NewReader(b), nil
+}
+
+// Close closes the underlying archive if it was opened with OpenZip.
+func (z *Zip) Close() error {
+	if z.c != nil {
+		return z.c.Close()
+	}
+	return nil
+}
+
+// file is an http.File for a file entry read from a zip archive or bindata
+type file struct {
+	io.ReadSeeker
+	fi os.FileInfo
+}
+
+func (f *file) Close() error               { return nil }
+func (f *file) Stat() (os.FileInfo, error) { return f.fi, nil }
+func (f *file) Readdir(int) ([]os.FileInfo, error) {
+	return nil, fmt.Errorf("%s is not a directory", f.fi.Name())
+}
+
+// folder is an http.File for a folder in a zip archive or bindata
+type folder struct {
+	fi      os.FileInfo
+	entries []os.FileInfo
+	pos     int
+}
+
+func (d *folder) Close() error               { return nil }
+func (d *folder) Stat() (os.FileInfo, error) { return d.fi, nil }
+func (d *folder) Read([]byte) (int, error)   { return 0, fmt.Errorf("%s is a directory", d.fi.Name()) }
+func (d *folder) Seek(int64, int) (int64, error) {
+	return 0, fmt.Errorf("%s is a directory", d.fi.Name())
+}
+
+// Readdir follows the semantics of os.File.Readdir
+func (d *folder) Readdir(count int) ([]os.FileInfo, error) {
+	rest := d.entries[d.pos:]
+	if count <= 0 {
+		d.pos = len(d.entries)
+		return rest, nil
+	}
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+	if count > len(rest) {
+		count = len(rest)
+	}
+	d.pos += count
+	return rest[:count], nil
+}
+
+// dirInfo is the os.FileInfo of a folder in a zip archive or bindata
+type dirInfo string
+
+func (d dirInfo) Name() string       { return string(d) }
+func (d dirInfo) Size() int64        { return 0 }
+func (d dirInfo) Mode() os.FileMode  { return os.ModeDir | 0555 }
+func (d dirInfo) ModTime() time.Time { return time.Time{} }
+func (d dirInfo) IsDir() bool        { return true }
+func (d dirInfo) Sys() interface{}   { return nil }
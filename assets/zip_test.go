@@ -0,0 +1,145 @@
+package assets
+
+import (
+	"archive/zip"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+var zipEntries = []struct {
+	name   string
+	method uint16
+	body   string
+}{
+	{"assets/app/index.html", zip.Deflate, "<html><body>" + strings.Repeat("Hello, Zip! ", 100) + "</body></html>"},
+	{"assets/img/logo.svg", zip.Store, "<svg>0123456789</svg>"},
+	{"classes.dex", zip.Store, "not an asset"},
+}
+
+// writeTestZip writes an ordinary zip archive mimicking an APK layout into a
+// temporary file and returns its path
+func writeTestZip(t *testing.T) string {
+	f, err := ioutil.TempFile("", "assets_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	for _, e := range zipEntries {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: e.name, Method: e.method})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(e.body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
+
+func fetch(url string, header map[string]string) (int, string, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return 0, "", err
+	}
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, "", fmt.Errorf("could not fetch from %s: %s", url, err)
+	}
+	defer res.Body.Close()
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return 0, "", fmt.Errorf("could not read response body from %s: %s", url, err)
+	}
+	return res.StatusCode, string(b), nil
+}
+
+func TestZip(t *testing.T) {
+	fpath := writeTestZip(t)
+	defer os.Remove(fpath)
+
+	z, err := OpenZip(fpath, "assets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer z.Close()
+
+	ts := httptest.NewServer(http.FileServer(z))
+	defer ts.Close()
+
+	tests := []struct {
+		path   string
+		header map[string]string
+		code   int
+		want   string
+	}{
+		{"/app/index.html", nil, http.StatusOK, zipEntries[0].body},
+		{"/img/logo.svg", nil, http.StatusOK, zipEntries[1].body},
+		{"/img/logo.svg", map[string]string{"Range": "bytes=5-9"}, http.StatusPartialContent, "01234"},
+		{"/app/index.html", map[string]string{"Range": "bytes=6-17"}, http.StatusPartialContent, "<body>Hello,"},
+		{"/classes.dex", nil, http.StatusNotFound, ""},
+		{"/app/missing.js", nil, http.StatusNotFound, ""},
+	}
+
+	for _, test := range tests {
+		code, got, err := fetch(ts.URL+test.path, test.header)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if code != test.code {
+			t.Errorf("%s: want status %d got %d", test.path, test.code, code)
+			continue
+		}
+		if code != http.StatusNotFound && got != test.want {
+			t.Errorf("%s: want: %s got: %s", test.path, test.want, got)
+		}
+	}
+
+	for name, want := range map[string]int64{"/app/index.html": int64(len(zipEntries[0].body)), "/img": 0} {
+		fi, err := z.Stat(name)
+		if err != nil {
+			t.Errorf("%s: %s", name, err)
+		} else if fi.Size() != want || fi.IsDir() != (want == 0) {
+			t.Errorf("%s: want size %d got %d dir %t", name, want, fi.Size(), fi.IsDir())
+		}
+	}
+	if _, err := z.Stat("/classes.dex"); !os.IsNotExist(err) {
+		t.Errorf("/classes.dex: want not exist got %v", err)
+	}
+}
+
+func TestZipReaddir(t *testing.T) {
+	fpath := writeTestZip(t)
+	defer os.Remove(fpath)
+
+	z, err := OpenZip(fpath, "assets/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer z.Close()
+
+	d, err := z.Open("/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fis, err := d.Readdir(-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fis) != 2 || fis[0].Name() != "app" || !fis[0].IsDir() || fis[1].Name() != "img" {
+		t.Errorf("unexpected root folder listing: %v", fis)
+	}
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/srinathh/mobilehtml5app/generator"
+)
+
+// bindOutput is the AAR written by bind relative to the project folder
+const bindOutput = generator.AndroidDir + "/libs/backend.aar"
+
+// bindPackages are the packages bound into bindOutput
+var bindPackages = []string{".", generator.NativePkg}
+
+// bindStamp records the hash of the inputs of the last bind next to
+// bindOutput
+const bindStamp = bindOutput + ".sha256"
+
+// bind runs gomobile bind for the project in the current folder unless the
+// AAR it writes is up to date with the Go sources, the module graph, the
+// tool versions and the options of the bind
+func bind(args []string) error {
+	fs := flag.NewFlagSet("bind", flag.ExitOnError)
+	abis := fs.String("abi", "", "Optional. Comma separated Android ABIs such as arm64-v8a,x86_64 to build the backend for. Defaults to the abis of the project configuration or all of them.")
+	tags := fs.String("tags", "", "Optional. Build tags to use when binding the webapp.")
+	force := fs.Bool("force", false, "Optional. Bind even if backend.aar is up to date.")
+	dryRun := fs.Bool("dry-run", false, "Optional. Print the gomobile command instead of running it. go list and go env still run to tell whether backend.aar is up to date.")
+	fs.Parse(args)
+
+	cfg, err := projectConfig(nil)
+	if err != nil {
+		return err
+	}
+	if *abis != "" {
+		cfg.ABIs = strings.Split(*abis, ",")
+	}
+	bindArgs, err := gomobileArgs(cfg.ABIs, *tags)
+	if err != nil {
+		return err
+	}
+	archs, err := goarchs(cfg.ABIs)
+	if err != nil {
+		return err
+	}
+	sum, err := bindHash(".", bindArgs, archs, *tags, bindPackages)
+	if err != nil {
+		return err
+	}
+	if !*force && bindUpToDate(sum) {
+		fmt.Printf("%s is up to date\n", bindOutput)
+		return nil
+	}
+
+	if *dryRun {
+		fmt.Printf("gomobile %s\n", strings.Join(bindArgs, " "))
+		return nil
+	}
+	cmd := exec.Command("gomobile", bindArgs...)
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("gomobile bind failed: %s", err)
+	}
+	return ioutil.WriteFile(bindStamp, []byte(sum+"\n"), 0644)
+}
+
+// gomobileArgs returns the arguments of gomobile binding the webapp for abis,
+// or every ABI if empty, with the build tags
+func gomobileArgs(abis []string, tags string) ([]string, error) {
+	target := "android"
+	if len(abis) > 0 {
+		archs, err := goarchs(abis)
+		if err != nil {
+			return nil, err
+		}
+		for i, arch := range archs {
+			archs[i] = "android/" + arch
+		}
+		target = strings.Join(archs, ",")
+	}
+	args := []string{"bind", "-target", target, "-o", bindOutput}
+	if tags = strings.TrimSpace(tags); tags != "" {
+		args = append(args, "-tags", tags)
+	}
+	return append(args, bindPackages...), nil
+}
+
+// goarchs returns the sorted GOARCH values gomobile builds abis with, or those
+// of every ABI if abis is empty
+func goarchs(abis []string) ([]string, error) {
+	if len(abis) == 0 {
+		for abi := range generator.AndroidABIs {
+			abis = append(abis, abi)
+		}
+	}
+	var archs []string
+	for _, abi := range abis {
+		t, ok := generator.AndroidABIs[strings.TrimSpace(abi)]
+		if !ok {
+			return nil, fmt.Errorf("abi must be one of armeabi-v7a, arm64-v8a, x86 or x86_64. Got %s", abi)
+		}
+		archs = append(archs, strings.TrimPrefix(t, "android/"))
+	}
+	sort.Strings(archs)
+	return archs, nil
+}
+
+// bindUpToDate reports whether bindOutput exists and was bound from inputs
+// with the hash sum
+func bindUpToDate(sum string) bool {
+	if _, err := os.Stat(bindOutput); err != nil {
+		return false
+	}
+	b, err := ioutil.ReadFile(bindStamp)
+	return err == nil && strings.TrimSpace(string(b)) == sum
+}
+
+// listedPackage is the part of the output of go list -json bindHash uses
+type listedPackage struct {
+	ImportPath string
+	Dir        string
+	Standard   bool
+	GoFiles    []string
+	CgoFiles   []string
+	CFiles     []string
+	CXXFiles   []string
+	HFiles     []string
+	SFiles     []string
+	SysoFiles  []string
+	EmbedFiles []string
+	Module     *listedModule
+}
+
+type listedModule struct {
+	Path    string
+	Version string
+	Replace *listedModule
+}
+
+// bindHash returns the hex encoded SHA-256 hash of bindArgs, of the versions
+// of go and gomobile and of the files the bind of pkgs in dir for android on
+// archs with tags depends on. These are go.mod, go.sum and go.work along with
+// the Go, cgo, C, assembly, syso and embedded files of pkgs and of the
+// packages they depend on as listed by go list for each of archs, other than
+// those of the standard library. Packages of versioned modules are hashed by
+// their version since go.sum pins their contents.
+func bindHash(dir string, bindArgs, archs []string, tags string, pkgs []string) (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "args %q\n", bindArgs)
+	for _, tool := range []string{"go", "gomobile"} {
+		out, err := exec.Command(tool, "version").CombinedOutput()
+		if err != nil {
+			// gomobile need not be installed for -dry-run
+			out = []byte("unavailable")
+		}
+		fmt.Fprintf(h, "%s version %s\n", tool, bytes.TrimSpace(out))
+	}
+
+	out, err := goOutput(dir, nil, "env", "GOMOD", "GOWORK")
+	if err != nil {
+		return "", err
+	}
+	env := strings.Split(strings.TrimSpace(out), "\n")
+	var modFiles []string
+	if gomod := env[0]; gomod != "" && gomod != os.DevNull {
+		modFiles = append(modFiles, gomod, strings.TrimSuffix(gomod, ".mod")+".sum")
+	}
+	if len(env) > 1 && env[1] != "" && env[1] != "off" {
+		modFiles = append(modFiles, env[1], env[1]+".sum")
+	}
+	for _, fpath := range modFiles {
+		if err := hashFile(h, filepath.Base(fpath), fpath); err != nil && !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+
+	args := []string{"list", "-deps", "-json"}
+	if tags = strings.TrimSpace(tags); tags != "" {
+		args = append(args, "-tags", tags)
+	}
+	args = append(args, pkgs...)
+	for _, arch := range archs {
+		// list the files gomobile compiles for arch rather than those of
+		// the host
+		out, err = goOutput(dir, []string{"GOOS=android", "GOARCH=" + arch, "CGO_ENABLED=1"}, args...)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "arch %s\n", arch)
+		if err := hashPackages(h, out); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashPackages writes the files of the packages listed by the output of go
+// list -json to h
+func hashPackages(h io.Writer, out string) error {
+	dec := json.NewDecoder(strings.NewReader(out))
+	for {
+		var p listedPackage
+		if err := dec.Decode(&p); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("could not decode go list output: %s", err)
+		}
+		if p.Standard {
+			continue
+		}
+		m := p.Module
+		if m != nil && m.Replace != nil {
+			m = m.Replace
+		}
+		if m != nil && m.Version != "" {
+			fmt.Fprintf(h, "package %s %s@%s\n", p.ImportPath, m.Path, m.Version)
+			continue
+		}
+		fmt.Fprintf(h, "package %s\n", p.ImportPath)
+		for _, files := range [][]string{p.GoFiles, p.CgoFiles, p.CFiles, p.CXXFiles, p.HFiles, p.SFiles, p.SysoFiles, p.EmbedFiles} {
+			for _, name := range files {
+				if err := hashFile(h, p.ImportPath+"/"+name, filepath.Join(p.Dir, name)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// goOutput returns the output of the go command run with args in dir and the
+// variables env added to the environment
+func goOutput(dir string, env []string, args ...string) (string, error) {
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	if env != nil {
+		cmd.Env = append(os.Environ(), env...)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("go %s failed: %s\n%s", strings.Join(args, " "), err, stderr.Bytes())
+	}
+	return string(out), nil
+}
+
+// hashFile writes name and the contents of the file fpath to h
+func hashFile(h io.Writer, name, fpath string) error {
+	b, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(h, "file %s %d\n", name, len(b))
+	h.Write(b)
+	return nil
+}
@@ -1,27 +1,223 @@
 package main
 
 import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/srinathh/mobilehtml5app/generator"
 )
 
-func TestMainDotJava(t *testing.T) {
-	pkgName = "testapp"
-	pkgPath = "com.example.testapp"
-	ret, _ := mainDotJava(nil)
-	if !strings.Contains(string(ret), "package com.example.testapp") {
-		t.Error("Error in package statement")
+func TestPreviewMain(t *testing.T) {
+	ret, err := previewMain("example.com/testapp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(ret), `webapp "example.com/testapp"`) {
+		t.Error("Error in webapp import")
+	}
+	if !strings.Contains(string(ret), "preview.Run(webapp.NewApp)") {
+		t.Error("Error in preview call")
+	}
+}
+
+func TestSplitTags(t *testing.T) {
+	if got := splitTags("dev, sqlite,,"); !reflect.DeepEqual(got, []string{"dev", "sqlite"}) {
+		t.Errorf("want [dev sqlite] got %v", got)
 	}
+}
 
-	if !strings.Contains(string(ret), "import go.testapp.Testapp") {
-		t.Error("Error in import statement")
+func TestExitStatus(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh not found")
+	}
+	err = exec.Command(sh, "-c", "exit 3").Run()
+	exit, ok := err.(*exec.ExitError)
+	if !ok {
+		t.Fatalf("want ExitError got %v", err)
+	}
+	if status := exitStatus(exit); status != 3 {
+		t.Errorf("want exit status 3 got %d", status)
 	}
+}
 
-	if !strings.Contains(string(ret), "Testapp.Start()") {
-		t.Error("Error in webapp start")
+func TestConfigFlags(t *testing.T) {
+	f := newConfigFlags("test")
+	if err := f.fs.Parse([]string{"-sharetypes", "text/plain, image/*,", "-gradle", "8.8"}); err != nil {
+		t.Fatal(err)
+	}
+	cfg := generator.Config{Name: "Todo", Gradle: generator.DefaultGradle, GradleSHA256: generator.DefaultGradleSHA256}
+	if err := f.apply(&cfg); err != nil {
+		t.Fatal(err)
+	}
+	want := generator.Config{Name: "Todo", Gradle: "8.8", ShareTypes: []string{"text/plain", "image/*"}}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("want %+v got %+v", want, cfg)
+	}
+
+	f = newConfigFlags("test")
+	if err := f.fs.Parse([]string{"-apitarget", "latest"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.apply(&cfg); err == nil {
+		t.Error("want error for -apitarget latest")
+	}
+}
+
+func TestTSGen(t *testing.T) {
+	methods, routes, err := loadAPI("testdata/tsgen", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, m := range methods {
+		names = append(names, m.Name)
+	}
+	if got := strings.Join(names, " "); got != "items.create items.delete items.list ping" {
+		t.Fatalf("unexpected methods %s", got)
+	}
+
+	var paths []string
+	for _, r := range routes {
+		paths = append(paths, r.Method+" "+r.Path)
+	}
+	if got := strings.Join(paths, ", "); got != "GET /items, PUT /items/:itemid, POST /items/new" {
+		t.Fatalf("unexpected routes %s", got)
 	}
 
-	if !strings.Contains(string(ret), "Testapp.Stop()") {
-		t.Error("Error in webapp stop")
+	typesTS, clientTS, err := genTypeScript(methods, routes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"export interface item {\n    Created: string;\n    tags?: { [key: string]: boolean };\n    id: string;\n    Text: string;\n    Priority: priority;\n    Parent: item | null;\n    Children: (item | null)[];\n    Extra: any;\n    Count: string;\n    Data: string;\n}\n",
+		"export type priority = number;\n",
+		"export interface deleteArgs {\n    IDs: string[];\n}\n",
+	} {
+		if !strings.Contains(string(typesTS), want) {
+			t.Errorf("types.d.ts does not contain %s:\n%s", want, typesTS)
+		}
+	}
+	for _, want := range []string{
+		`xhr.open("POST", "/_rpc");`,
+		"    items: {\n        create(params: types.item): Promise<types.item> {\n            return call<types.item>(\"items.create\", params);\n        },\n",
+		"delete(params: types.deleteArgs): Promise<{ Deleted: number; }> {",
+		"list(): Promise<types.item[]> {\n            return call<types.item[]>(\"items.list\");",
+		"    ping(): Promise<string> {",
+		"    // GET /items\n    getItems(): Promise<types.item[]> {\n        return request<types.item[]>(\"GET\", \"/items\", {decode: true});\n    },\n",
+		`postItemsNew(params: types.item): Promise<void> {`,
+		`return request<void>("POST", "/items/new", {params: params, form: "data"});`,
+		`putItemsByItemid(itemid: string, params: types.deleteArgs): Promise<{ Deleted: number; }> {`,
+		`return request<{ Deleted: number; }>("PUT", "/items/" + encodeURIComponent(itemid), {params: params, decode: true});`,
+	} {
+		if !strings.Contains(string(clientTS), want) {
+			t.Errorf("client.ts does not contain %s:\n%s", want, clientTS)
+		}
+	}
+}
+
+func TestBindHash(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go list in short mode")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not found")
+	}
+	dir, err := ioutil.TempDir("", "mobilehtml5app-bind")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	write := func(name, content string) {
+		fpath := filepath.Join(dir, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(fpath), 0755)
+		if err := ioutil.WriteFile(fpath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("app/go.mod", "module example.com/app\n\ngo 1.16\n\nrequire example.com/lib v0.0.0\n\nreplace example.com/lib => ../lib\n")
+	write("app/app.go", "package app\n\nimport _ \"example.com/lib\"\n")
+	write("lib/go.mod", "module example.com/lib\n\ngo 1.16\n")
+	write("lib/lib.go", "package lib\n")
+	write("app/page.html", "<html></html>\n")
+	project := filepath.Join(dir, "app")
+	defer os.Setenv("GO111MODULE", os.Getenv("GO111MODULE"))
+	defer os.Setenv("GOPATH", os.Getenv("GOPATH"))
+	os.Setenv("GO111MODULE", "on")
+
+	args, err := gomobileArgs([]string{"x86_64", "arm64-v8a"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"bind", "-target", "android/amd64,android/arm64", "-o", bindOutput, ".", "github.com/srinathh/mobilehtml5app/native"}; !reflect.DeepEqual(args, want) {
+		t.Errorf("want args %v got %v", want, args)
+	}
+	if _, err := gomobileArgs([]string{"mips"}, ""); err == nil {
+		t.Error("want error for invalid abi")
+	}
+	archs, err := goarchs(nil)
+	if want := []string{"386", "amd64", "arm", "arm64"}; err != nil || !reflect.DeepEqual(archs, want) {
+		t.Errorf("want archs %v got %v %v", want, archs, err)
+	}
+	archs, _ = goarchs([]string{"x86_64", "arm64-v8a"})
+
+	pkgs := []string{"."}
+	sum, err := bindHash(project, args, archs, "", pkgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"app/app_test.go", "app/androidapp/Main.go", "app/README.md", "lib/unused/unused.go", "app/app_windows.go", "app/app_386.go"} {
+		write(name, "package app\n")
+		if got, err := bindHash(project, args, archs, "", pkgs); got != sum {
+			t.Errorf("want hash unchanged by %s got %v", name, err)
+		}
+	}
+
+	tagged, _ := gomobileArgs(nil, "dev")
+	if got, _ := bindHash(project, tagged, archs, "dev", pkgs); got == sum {
+		t.Error("want hash changed by bind options")
+	}
+	for _, change := range [][2]string{
+		{"app/app.go", "package app\n\nimport _ \"example.com/lib\"\n\nvar X int\n"},
+		{"lib/lib.go", "package lib\n\nvar Y int\n"},
+		{"lib/cgo.go", "package lib\n\nimport \"C\"\n"},
+		{"lib/cgo.h", "int answer(void);\n"},
+		{"lib/cgo.c", "int answer(void) { return 42; }\n"},
+		{"app/app_android.go", "package app\n"},
+		{"app/app_arm64.s", "// arm64\n"},
+		{"app/embed.go", "package app\n\nimport _ \"embed\"\n\n//go:embed page.html\nvar page string\n"},
+		{"app/page.html", "<html>changed</html>\n"},
+		{"app/go.sum", "example.com/dep v1.0.0 h1:abc=\n"},
+	} {
+		write(change[0], change[1])
+		got, err := bindHash(project, args, archs, "", pkgs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got == sum {
+			t.Errorf("want hash changed by %s", change[0])
+		}
+		sum = got
+	}
+
+	// outside of modules the packages are found in GOPATH
+	gopath := filepath.Join(dir, "gopath")
+	write("gopath/src/example.com/old/old.go", "package old\n\nimport _ \"example.com/olddep\"\n")
+	write("gopath/src/example.com/olddep/dep.go", "package olddep\n")
+	old := filepath.Join(gopath, "src", "example.com", "old")
+	os.Setenv("GO111MODULE", "off")
+	os.Setenv("GOPATH", gopath)
+	sum, err = bindHash(old, args, archs, "", pkgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	write("gopath/src/example.com/olddep/dep.go", "package olddep\n\nvar Z int\n")
+	if got, _ := bindHash(old, args, archs, "", pkgs); got == sum {
+		t.Error("want hash changed by a GOPATH dependency")
 	}
 }
@@ -5,8 +5,8 @@ backend. It currently supports Android only.
 
 Usage
 
-First create a folder within your GOPATH where you want your project to reside
-and chdir into it. From within this folder run the mobilehtml5app command
+First create a folder within a Go module or your GOPATH where you want your
+project to reside and chdir into it. From within this folder run the mobilehtml5app command
 as discussed in the platform specific sections below. This generates a
 Go language HTTP server scaffolding for your app and a mobile platform specific
 App project with a WebView that loads webpages from the server.
@@ -17,7 +17,17 @@ lifecycle function hooks of the native portion of the App which houses the webvi
 The file webapp.go also will have two sample handlers to illustrate how to
 create and register your HTTP handlers. The gomobile bind command is used
 to generate the required shared library and is hooked up to the native build
-process to automatically re-build the go shared library.
+process to automatically re-build the go shared library. The generated App
+also has a SetWebView() method through which the native portion can let the
+backend navigate, reload or run scripts in the WebView, for which the
+github.com/srinathh/mobilehtml5app/native package is bound along with the
+webapp, a ResolveLink() method returning the in-app URL to load for a deep
+link the app was opened with and a ReceiveShares() method setting the folder
+content shared by other apps is stored in by the native.ShareReceiver returned
+by Shares().
+Background work that has to run while the UI is closed, for instance from
+WorkManager, can be registered as a task in NewApp and run by the native
+portion through the RunTask() method of the App without starting the server.
 
 The webapp uses an server that integrates graceful shutdown and parameterized routing. It
 requires handlers to satisfy the ContextHandler interface similar to http.Handler but
@@ -35,215 +45,642 @@ github.com/tylerb/graceful which are used in the Server.
 Android apps
 
 To create an Android project run the following command in the project folder
-you create for your mobile app under your GOPATH.
+you create for your mobile app.
 
-	mobilehtml5app -apitarget <Build API Target> -name <Project Name>
+	mobilehtml5app -name <Project Name>
 
 This will generate webapp.go and an Android gradle based project in a subfolder
-called androidapp. You can build the Android project thorugh the command line. To
-work with it it in Android Studio, make sure to select "Import Project" in the
-first screen rather than "Open Project".
-
-There are two options for the WebView - the Android System WebView or the
-Apache CrossWalk project XWalkView. The Android System WebView is a reliable
-HTML5 platform only if you are targeting Android Kit-Kat (4.4) or higher devices
-in which the WebView is based on Chromium. The CrossWalk project XWalkView has
-compatibility from Android Ice Cream Sandwitch (4.0) version onwards and is the
-default version used.
+called androidapp. The whole project is generated from templates so no Android
+SDK tools are needed to generate it. The Android SDK and gomobile are needed
+only to build it since the gradle build runs mobilehtml5app bind to generate
+libs/backend.aar with gomobile bind before every build, so mobilehtml5app must
+be on the PATH as well. The bundled gradle wrapper downloads the gradle version
+of the project configuration on first use, so build the app with ./gradlew
+assembleDebug in the androidapp folder. The project is generated by the
+github.com/srinathh/mobilehtml5app/generator package which can also be used
+from other tooling.
+
+The Go import path of the project folder is derived from the nearest go.mod or,
+outside of a module, from GOPATH and can be set explicitly with -module. The
+Java package of the Android project is the import path with the domain
+reversed such as com.example.my_app.androidapp for example.com/my-app. Parts
+are lower cased, characters other than letters, digits and underscores become
+underscores, Java keywords get an underscore suffix and parts starting with a
+digit are prefixed with x.
+
+The app uses the Android System WebView which is based on Chromium from
+Android Kit-Kat (4.4) onwards. The Apache CrossWalk project XWalkView is no
+longer supported since the project has been discontinued and its maven
+repository is gone.
 
 The full set of command line options for building Android apps are:
 	-apitarget string
-		Required. Android build target. To list possible targets run
-		$ANDROID_HOME/tools/android list targets
+		Optional. Android API level to compile against and target.
+		(default "android-34")
+	-deeplinks string
+		Optional. Comma separated URL prefixes such as myapp://items or
+		https://example.com/items opening the app. The links are resolved
+		to in-app URLs by App.ResolveLink in webapp.go. Replaces the deep
+		links of the project configuration.
+	-dry-run
+		Optional. Print the files that would be created, modified or
+		deleted along with diffs of modified files without changing them or
+		running any external commands. Also applies to the subcommands
+		changing the project such as upgrade. bind still runs the go
+		command to tell whether the backend is up to date.
 	-gradle string
-		Optional. Gradle version. (default "2.4")
+		Optional. Gradle version of the gradle wrapper. (default "8.7")
+	-gradlesha256 string
+		Optional. SHA-256 checksum of the -gradle distribution verified by
+		the gradle wrapper. Known for the default version.
 	-name string
 		Required. Android project name composed of a-z A-Z 0-9 _
+	-keepalive
+		Optional. Generate a foreground Service owning the App so that the
+		server keeps running while the app is in the background. Requires
+		-apitarget android-29 or higher.
+	-keyalias string
+		Optional. Alias of the key in -keystore to sign the release version
+		of the app with.
+	-keystore string
+		Optional. Keystore relative to the project folder to sign the
+		release version of the app with. The passwords of the keystore and
+		the key are read from $MOBILEHTML5APP_STORE_PASSWORD and
+		$MOBILEHTML5APP_KEY_PASSWORD when building. Requires -keyalias.
+	-module string
+		Optional. Go import path of the package in the current folder.
+		Derived from go.mod or GOPATH if omitted.
+	-permissions string
+		Optional. Comma separated Android permissions requested in
+		addition to those needed by the app such as CAMERA or
+		android.permission.RECORD_AUDIO. Replaces the permissions of the
+		project configuration.
 	-plugin string
-		Optional. Android gradle plugin version. (default "1.3.0")
+		Optional. Android gradle plugin version. (default "8.5.2")
+	-sharetypes string
+		Optional. Comma separated MIME types such as text/plain or image/*
+		of content other apps can share into the app. The content is
+		passed to App.Shares in webapp.go. Replaces the share types of
+		the project configuration.
 	-target string
 		Optional. Supports only android for now. (default "android")
 	-title string
 		Optional. App Title defaults to -name if omitted.
 	-webview string
-		Optional. Can be either xwalk (to use CrossWalk XWalkView) or system
-		(to use Android WebView). Note that only KitKat (API19) and above
-		have a system WebView supporting modern HTML5 capabilities based on
-		Chromium and we set 19 as the minSdkVersion. (default "xwalk")
+		Optional. Must be system to use the Android WebView. The
+		discontinued CrossWalk Webview selected by xwalk is no longer
+		supported. (default "system")
+
+Project configuration
+
+The configuration of the project is stored in mobilehtml5app.json in the
+project folder when it is generated and is read every time the command runs in
+the folder, so flags need to be given only to change it. Flags set on the
+command line override the file. The file describes the targets, the Android
+API level, gradle and plugin versions, the WebView, additional permissions such
+as CAMERA, meta-data of the application such as API keys of services, the deep
+links opening the app, the MIME types other apps can share into it and the
+image the launcher icons are rendered from relative to the project folder:
+
+	{
+	  "name": "TodoApp",
+	  "title": "Todo",
+	  "module": "example.com/todoapp",
+	  "package": "todoapp",
+	  "targets": ["android"],
+	  "apiLevel": 34,
+	  "gradle": "8.7",
+	  "plugin": "8.5.2",
+	  "webview": "system",
+	  "permissions": ["CAMERA"],
+	  "metaData": {"com.example.maps.API_KEY": "secret"},
+	  "deepLinks": ["todoapp://items", "https://example.com/items"],
+	  "shareTypes": ["text/plain", "image/*"],
+	  "icon": "logo.png",
+	  "iconBackground": "#FFFFFF",
+	  "version": "1.2.0",
+	  "versionCode": 5,
+	  "keystore": "release.jks",
+	  "keyAlias": "todoapp",
+	  "abis": ["arm64-v8a", "x86_64"]
+	}
+
+To print the configuration that would be used after applying flags and
+defaults, run
+
+	mobilehtml5app config [flags]
+
+Upgrading projects
+
+The files generated for a project are recorded in .mobilehtml5app.pristine.json
+in the project folder which should be committed along with the project. To pick
+up changes to the templates of a newer version of the command or to apply a
+change of the configuration, run the following command in the project folder.
+
+	mobilehtml5app upgrade [flags]
+
+Files that were not changed since they were generated are replaced with the
+new version while changes made to generated files are merged with the changes
+to the templates line by line. Files whose changes conflict are not written.
+The merged text is written next to them with the extension .merge instead and
+the conflicting regions are printed, marked with the local lines, the lines as
+last generated and the upgraded lines like diff3 does, to be resolved by hand.
+Until a conflict is resolved, upgrade merges the file again against the lines
+as last generated. Once it merges cleanly the .merge file is removed.
+Projects generated without .mobilehtml5app.pristine.json are merged against
+the lines the local and the upgraded files have in common. Binary files such
+as icons are never merged. They are replaced only if they were not changed
+locally. Files removed locally are not added back.
+
+Releases
+
+The release version of the app is signed with the key -keyalias from the
+keystore -keystore when both are set, for instance with
+
+	mobilehtml5app upgrade -keystore release.jks -keyalias todoapp
+
+The passwords are never stored in the project. They are read from the
+environment variables MOBILEHTML5APP_STORE_PASSWORD and
+MOBILEHTML5APP_KEY_PASSWORD when gradle builds the release.
+
+The version of the app is stored in the project configuration and written to
+build.gradle along with version.go declaring the constants Version and
+VersionCode in the Go package. The generated webapp serves them as JSON at
+/_version. To print the version or to increment it for a release, run
+
+	mobilehtml5app version
+	mobilehtml5app version bump [-dry-run] [major|minor|patch]
+
+Bumping increments the given part of the version, the patch by default, along
+with the version code and upgrades the project.
+
+Binding the backend
+
+The gradle build binds the Go backend into androidapp/libs/backend.aar by
+running the following command in the project folder, which can also be run by
+hand.
+
+	mobilehtml5app bind [-abi <ABIs>] [-tags <Build Tags>] [-force] [-dry-run]
+
+Since gomobile bind takes a while, the bind is skipped if backend.aar was bound
+from the same files, go.mod, go.sum, go.work, versions of go and gomobile and
+options. The files are the Go, cgo and go:embed files of the bound packages
+and the packages they import other than the standard library, as listed by go
+list both inside and outside of modules. Packages of versioned modules are
+considered by their version. The hash of the last bind is stored next to
+backend.aar.
+
+The backend is built for every Android ABI unless -abi or the abis of the
+project configuration select some of armeabi-v7a, arm64-v8a, x86 and x86_64,
+for instance arm64-v8a,x86_64 to build for phones and the emulator only. With
+-dry-run the gomobile command is printed instead of being run. go list, go env
+and the version commands of go and gomobile still run to tell whether
+backend.aar is up to date.
+
+Launcher icons
+
+The placeholder launcher icon is replaced with icons rendered from a PNG or
+JPEG image such as a logo by running the following command in the project
+folder.
+
+	mobilehtml5app icons -src <Image> [-background <#RRGGBB>] [-splash <#RRGGBB>]
+
+This renders the icon for every mipmap density along with the foreground layer
+of the adaptive icon shown by Android 8 and later on a background layer of the
+-background colour, white by default. The image is scaled to fit the area of
+adaptive icons that launchers never mask, so logos should have a transparent
+background and little margin. With -splash the Main activity shows the icon on
+that colour until the WebView draws the first page. The image and colours are
+stored in the project configuration and the icons are upgraded like the rest
+of the project, so -dry-run can be used as well.
+
+Permissions and meta-data are added to AndroidManifest.xml by parsing it so
+that they are not duplicated and the rest of the manifest is left as it was.
+Removing a permission from the configuration and upgrading removes it from the
+manifest unless the manifest was changed around it locally.
+
+Keeping the server alive
+
+By default the native portion stops the server when the app is paused which
+ends any upload or computation the user started. With -keepalive a foreground
+Service owning the App is generated instead. The Activity binds to the
+Service and calls the Detach() and Attach() methods of the App when it is
+paused and resumed, which mark the server as detached from the UI while it
+keeps running. The Service, and with it the server, is stopped when the user
+leaves the app with the back key.
+
+Desktop preview
+
+To try the webapp in a desktop browser without building the Android app, run
+the following command in the project folder.
+
+	mobilehtml5app serve [-tags dev] [-data <Data Dir>] [-lan <Address> [-qr]]
+
+This builds the package in the current folder, creates the App by calling
+NewApp with the data folder, starts it and prints the URL to open. NewApp may
+take no parameters or the data folder as a string and may return an error
+along with the App. A temporary data folder is used if -data is omitted.
+Press Ctrl+C to stop the App. serve exits with the exit status of the App.
+
+To open the webapp from the browser of a phone on the same network, pass a
+LAN address such as :8080 with -lan. Since the App is not otherwise exposed
+to the network, the printed LAN URL carries a random access token. Add -qr
+to also print the LAN URL as a QR code to scan with the phone.
+
+TypeScript declarations
+
+Go functions called from the frontend through the rpc package and routes of
+the ContextRouter handling JSON can be given TypeScript types so that
+changing a Go struct breaks the frontend build instead of failing at runtime.
+Run the following command in the project folder, or add it as a go:generate
+directive to webapp.go.
+
+	mobilehtml5app tsgen [-o <Output Dir>] [-tags <Comma Separated Build Tags>]
+
+This type checks the package in the current folder, finds the functions
+registered with rpc.Server.Register and writes types.d.ts with an interface
+for each struct used as an argument or result along with client.ts exporting
+an rpc object with a typed function per registered method. Field names,
+omitted fields and optional fields follow the rules of encoding/json. Method
+names must be constant strings.
+
+Routes registered with ContextRouter.Handle or HandleFunc with constant
+methods and paths are added to a routes object of client.ts, for instance
+routes.getItems() for GET /items, if their handler is declared in the package
+and decodes the request or encodes the response with encoding/json. The
+request and response types are those of the first Decode or Unmarshal and
+the first Encode or Marshal in the handler. A request unmarshaled from
+r.FormValue("data") or r.PostFormValue("data") is sent in the form field
+data.
 */
 package main
 
 import (
-	"bytes"
+	"encoding/json"
 	"flag"
 	"fmt"
-	"go/build"
-	"go/format"
-	"io/ioutil"
 	"log"
 	"os"
-	"os/exec"
-	"path/filepath"
-	"runtime"
+	"strconv"
 	"strings"
+
+	"github.com/srinathh/mobilehtml5app/generator"
+	"golang.org/x/net/context"
 )
 
-var target, androidToolPath, name, apitarget, gradle, plugin, title, webview, pkgPath, pkgName, outPath string
+// configFlags are the flags of a subcommand overriding the project
+// configuration
+type configFlags struct {
+	fs                                                         *flag.FlagSet
+	target, module, name, apitarget, gradle, gradleSHA256      string
+	plugin, title, webview, permissions, deeplinks, shareTypes string
+	keystore, keyalias                                         string
+	keepalive, dryRun                                          bool
+}
 
-func init() {
-	flag.StringVar(&target, "target", "android", "Optional. Supports only android for now.")
-	flag.StringVar(&name, "name", "", "Required. Android project name composed of a-z A-Z 0-9 _")
-	flag.StringVar(&apitarget, "apitarget", "", "Required. Android build target. To list possible targets run $ANDROID_HOME/tools/android list targets")
-	flag.StringVar(&gradle, "gradle", "2.4", "Optional. Gradle version.")
-	flag.StringVar(&plugin, "plugin", "1.3.0", "Optional. Android gradle plugin version.")
-	flag.StringVar(&title, "title", "", "Optional. App Title defaults to -name if omitted.")
-	flag.StringVar(&webview, "webview", "xwalk", "Optional. Can be either xwalk (to use CrossWalk Webview) or system (to use Android WebView). Note that only KitKat (API19) and above have a system WebView supporting modern HTML5 capabilities based on Chromium and we set 19 as the minSdkVersion.")
+// newConfigFlags returns the flags of the subcommand name overriding the
+// project configuration
+func newConfigFlags(name string) *configFlags {
+	f := &configFlags{fs: flag.NewFlagSet(name, flag.ExitOnError)}
+	fs := f.fs
+	fs.StringVar(&f.target, "target", "android", "Optional. Supports only android for now.")
+	fs.StringVar(&f.module, "module", "", "Optional. Go import path of the package in the current folder. Derived from go.mod or GOPATH if omitted.")
+	fs.StringVar(&f.keystore, "keystore", "", fmt.Sprintf("Optional. Keystore relative to the project folder to sign the release version of the app with. The passwords of the keystore and the key are read from $%s and $%s when building. Requires -keyalias.", generator.StorePasswordEnv, generator.KeyPasswordEnv))
+	fs.StringVar(&f.keyalias, "keyalias", "", "Optional. Alias of the key in -keystore to sign the release version of the app with.")
+	fs.StringVar(&f.name, "name", "", "Required. Android project name composed of a-z A-Z 0-9 _")
+	fs.StringVar(&f.apitarget, "apitarget", fmt.Sprintf("android-%d", generator.DefaultAPILevel), "Optional. Android API level to compile against and target.")
+	fs.StringVar(&f.gradle, "gradle", generator.DefaultGradle, "Optional. Gradle version of the gradle wrapper.")
+	fs.StringVar(&f.gradleSHA256, "gradlesha256", "", "Optional. SHA-256 checksum of the -gradle distribution verified by the gradle wrapper. Known for the default version.")
+	fs.StringVar(&f.plugin, "plugin", generator.DefaultPlugin, "Optional. Android gradle plugin version.")
+	fs.StringVar(&f.title, "title", "", "Optional. App Title defaults to -name if omitted.")
+	fs.StringVar(&f.permissions, "permissions", "", "Optional. Comma separated Android permissions requested in addition to those needed by the app such as CAMERA or android.permission.RECORD_AUDIO. Replaces the permissions of the project configuration.")
+	fs.StringVar(&f.deeplinks, "deeplinks", "", "Optional. Comma separated URL prefixes such as myapp://items or https://example.com/items opening the app. The links are resolved to in-app URLs by App.ResolveLink in webapp.go. Replaces the deep links of the project configuration.")
+	fs.StringVar(&f.shareTypes, "sharetypes", "", "Optional. Comma separated MIME types such as text/plain or image/* of content other apps can share into the app. The content is passed to App.Shares in webapp.go. Replaces the share types of the project configuration.")
+	fs.BoolVar(&f.keepalive, "keepalive", false, fmt.Sprintf("Optional. Generate a foreground Service owning the App so that the server keeps running while the app is in the background. Requires -apitarget android-%d or higher.", generator.MinKeepAliveAPI))
+	fs.BoolVar(&f.dryRun, "dry-run", false, "Optional. Print the files that would be created, modified or deleted along with diffs of modified files without changing them or running any external commands.")
+	fs.StringVar(&f.webview, "webview", generator.DefaultWebView, "Optional. Must be system to use the Android WebView. The discontinued CrossWalk Webview selected by xwalk is no longer supported.")
+	return f
 }
 
-func exitError(err error) {
-	log.Printf("Error: %s\n", err)
-	log.Fatalln("For usage details, run mobilehtml5app -help")
-	log.Fatalln()
+// apply overrides cfg with the flags set on the command line
+func (f *configFlags) apply(cfg *generator.Config) error {
+	var err error
+	f.fs.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "target":
+			cfg.Targets = []string{f.target}
+		case "module":
+			cfg.ImportPath, cfg.PackageName = f.module, ""
+		case "name":
+			cfg.Name = f.name
+		case "title":
+			cfg.Title = f.title
+		case "apitarget":
+			level, perr := strconv.Atoi(strings.TrimPrefix(f.apitarget, "android-"))
+			if perr != nil {
+				err = fmt.Errorf("-apitarget must be an API level like android-%d. Got %s", generator.DefaultAPILevel, f.apitarget)
+			}
+			cfg.APILevel = level
+		case "gradle":
+			// the checksum of another version no longer applies
+			cfg.Gradle, cfg.GradleSHA256 = f.gradle, ""
+		case "gradlesha256":
+			cfg.GradleSHA256 = f.gradleSHA256
+		case "plugin":
+			cfg.Plugin = f.plugin
+		case "webview":
+			cfg.WebView = f.webview
+		case "keepalive":
+			cfg.KeepAlive = f.keepalive
+		case "keystore":
+			cfg.Keystore = f.keystore
+		case "keyalias":
+			cfg.KeyAlias = f.keyalias
+		case "permissions":
+			cfg.Permissions = splitList(f.permissions)
+		case "deeplinks":
+			cfg.DeepLinks = splitList(f.deeplinks)
+		case "sharetypes":
+			cfg.ShareTypes = splitList(f.shareTypes)
+		}
+	})
+	return err
 }
 
-type modBytes func([]byte) ([]byte, error)
+// splitList returns the non empty elements of the comma separated list s
+func splitList(s string) []string {
+	var ret []string
+	for _, e := range strings.Split(s, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			ret = append(ret, e)
+		}
+	}
+	return ret
+}
 
-func modFile(fpath string, modfn modBytes) {
-	b, err := ioutil.ReadFile(fpath)
+// projectConfig returns the configuration stored in the project folder in the
+// current folder overridden by the flags f set on the command line, if any
+func projectConfig(f *configFlags) (generator.Config, error) {
+	cfg, err := generator.LoadConfig(generator.DirFS("."))
 	if err != nil {
-		exitError(fmt.Errorf("unable to read %s: %s", fpath, err))
+		return cfg, err
 	}
-	w, err := modfn(b)
-	if err != nil {
-		exitError(fmt.Errorf("error processing %s: %s", fpath, err))
+	if f != nil {
+		if err := f.apply(&cfg); err != nil {
+			return cfg, err
+		}
 	}
-	if err := ioutil.WriteFile(fpath, w, 0644); err != nil {
-		exitError(fmt.Errorf("umable to write changes to build.gradle: %s", err))
+
+	// figure out the package name from the current working directory
+	// and set the import path for the java app
+	if cfg.ImportPath == "" || cfg.PackageName == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return cfg, fmt.Errorf("couldn't fetch working dir path: %s", err)
+		}
+		if cfg.ImportPath, cfg.PackageName, err = generator.DetectPackage(wd, cfg.ImportPath); err != nil {
+			return cfg, fmt.Errorf("%s. Pass the import path with -module", err)
+		}
 	}
+	return cfg.WithDefaults(), nil
 }
 
-func genAndroid() {
-	// Check for existance of the android tool in the sdk path
-	toolPath := filepath.Join(os.Getenv("ANDROID_HOME"), "tools", "android")
-	if runtime.GOOS == "windows" {
-		toolPath = toolPath + ".bat"
-	}
-	if _, err := os.Stat(toolPath); err != nil {
-		exitError(fmt.Errorf("couldn't find %s. Environment variable ANDROID_HOME must be defined and point to a valid Android SDK folder", toolPath))
+// projectFS returns the FS of the project in the current folder which only
+// records changes if dryRun is set
+func projectFS(dryRun bool) generator.FS {
+	if dryRun {
+		return generator.NewDryRun(generator.DirFS("."))
 	}
+	return generator.DirFS(".")
+}
 
-	// -name and -apitarget must be provided
-	if name == "" {
-		exitError(fmt.Errorf("-name must be specified"))
+// printChanges prints the changes recorded by fs with -dry-run
+func printChanges(fs generator.FS) error {
+	d, ok := fs.(*generator.DryRun)
+	if !ok {
+		return nil
 	}
-
-	if apitarget == "" {
-		exitError(fmt.Errorf("-target must be specified"))
+	changes, err := d.Changes()
+	if err != nil {
+		return err
 	}
-
-	if !(webview == "xwalk" || webview == "system") {
-		exitError(fmt.Errorf("-webview must be either xwalk or system. Got %s", webview))
+	for _, c := range changes {
+		fmt.Printf("%s %s\n", c.Action, c.Path)
+		// the record of generated files is not meant to be read
+		if c.Path != generator.PristineFile {
+			os.Stdout.Write(c.Diff)
+		}
 	}
+	return nil
+}
 
-	// figure out the package name from the current working directory
-	// and set the import path for the java app
-	wd, err := os.Getwd()
+// genAndroid generates the project in the current folder
+func genAndroid(args []string) error {
+	f := newConfigFlags("mobilehtml5app")
+	f.fs.Parse(args)
+	cfg, err := projectConfig(f)
 	if err != nil {
-		exitError(fmt.Errorf("couldn't fetch working dir path: %s", err))
+		return err
 	}
-
-	pkgName = filepath.Base(wd)
-	pkg, err := build.ImportDir(wd, build.FindOnly)
-	if err != nil || pkg.ImportPath == "" {
-		exitError(fmt.Errorf("could not derive package path from import path"))
+	fs := projectFS(f.dryRun)
+	if err := generator.Generate(context.Background(), cfg, fs); err != nil {
+		return err
 	}
-	pkgPath = javaImportPath(pkg.ImportPath) + ".androidapp"
+	return printChanges(fs)
+}
 
-	if title == "" {
-		title = name
+// printConfig prints the configuration given by the project folder and args
+// as it would be used to generate the project
+func printConfig(args []string) error {
+	f := newConfigFlags("config")
+	f.fs.Parse(args)
+	cfg, err := projectConfig(f)
+	if err != nil {
+		return err
 	}
-	outPath = "./androidapp"
-
-	// Project Generation: Run the android tool and then generate the go files
-	{
-		if out, err := exec.Command(toolPath, "create", "project", "--name", name, "--package", pkgPath, "--activity", "Main", "--target", apitarget, "--gradle", "--gradle-version", plugin, "--path", outPath).CombinedOutput(); err != nil {
-			exitError(fmt.Errorf("error in android tool: %s\n%s", err, out))
-		}
-		srccode, err := format.Source([]byte(fmt.Sprintf("package %s\n%s", pkgName, webapp)))
-		if err != nil {
-			exitError(fmt.Errorf("gofmt error : %s", err))
-		}
-
-		if err := ioutil.WriteFile("webapp.go", srccode, 0644); err != nil {
-			exitError(fmt.Errorf("error writing webapp.go: %s", err))
-		}
+	b, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
 	}
+	fmt.Printf("%s\n", b)
+	return nil
+}
 
-	// build.gradle: add dependencies for gomobile bind and XWalkView; fix minifyEnabled
-	modFile(filepath.Join(outPath, "build.gradle"), buildDotGradle)
+// exitCode is returned by a subcommand to exit with the status of a program
+// it ran
+type exitCode int
 
-	// libs: create the folder
-	if err := os.Mkdir(filepath.Join(outPath, "libs"), os.ModeDir|0775); err != nil {
-		exitError(fmt.Errorf("unable to create libs folder: %s", err))
-	}
+func (c exitCode) Error() string {
+	return fmt.Sprintf("exit status %d", int(c))
+}
 
-	// gradle-wrapper.properties: update to a more modern gradle version
-	modFile(filepath.Join(outPath, "gradle", "wrapper", "gradle-wrapper.properties"), gradlewrapperDotProperties)
+// subcommands maps the first argument to the function running the subcommand
+// with the remaining arguments
+var subcommands = map[string]func(args []string) error{
+	"serve":   serve,
+	"tsgen":   tsgen,
+	"config":  printConfig,
+	"upgrade": upgrade,
+	"icons":   icons,
+	"version": appVersion,
+	"bind":    bind,
+}
 
-	// AndroidManifest.xml: add permissions for INTERNET and NETWORK_STATE
-	modFile(filepath.Join(outPath, "src", "main", "AndroidManifest.xml"), androidManifestDotXML)
+// run runs the subcommand given by args or generates the project
+func run(args []string) error {
+	if len(args) > 0 {
+		if cmd, ok := subcommands[args[0]]; ok {
+			return cmd(args[1:])
+		}
+	}
+	return genAndroid(args)
+}
 
-	// strings.xml: modify the value of app_name to title
-	modFile(filepath.Join(outPath, "src", "main", "res", "values", "strings.xml"), func(b []byte) ([]byte, error) {
-		return bytes.Replace(b, []byte("Main"), []byte(title), 1), nil
-	})
+// upgrade upgrades the project in the current folder to the current templates
+// and the configuration given by the project folder and args
+func upgrade(args []string) error {
+	f := newConfigFlags("upgrade")
+	f.fs.Parse(args)
+	cfg, err := projectConfig(f)
+	if err != nil {
+		return err
+	}
+	return runUpgrade(cfg, f.dryRun)
+}
 
-	// main.xml: remove the current layout file
-	if err := os.Remove(filepath.Join(outPath, "src", "main", "res", "layout", "main.xml")); err != nil {
-		exitError(fmt.Errorf("unable to remove main.xml: %s", err))
+// icons sets the launcher icon of the project in the current folder to the
+// image given by args and upgrades the project to render it
+func icons(args []string) error {
+	fs := flag.NewFlagSet("icons", flag.ExitOnError)
+	src := fs.String("src", "", "Required. PNG or JPEG image relative to the project folder to render the launcher icons from.")
+	background := fs.String("background", "", fmt.Sprintf("Optional. Colour of the background layer of the adaptive icon as #RRGGBB. (default %q)", generator.DefaultIconBackground))
+	splash := fs.String("splash", "", "Optional. Background colour as #RRGGBB of a splash screen showing the icon while the app starts.")
+	dryRun := fs.Bool("dry-run", false, "Optional. Print the changes without making them.")
+	fs.Parse(args)
+
+	cfg, err := projectConfig(nil)
+	if err != nil {
+		return err
 	}
+	if *src == "" && cfg.Icon == "" {
+		return fmt.Errorf("-src must be specified")
+	}
+	if *src != "" {
+		cfg.Icon = *src
+	}
+	if *background != "" {
+		cfg.IconBackground = *background
+	}
+	if *splash != "" {
+		cfg.Splash = *splash
+	}
+	return runUpgrade(cfg, *dryRun)
+}
 
-	// finally write the new contents of Main.java
-	fpath := filepath.Join(outPath, "src", "main", "java")
-	for _, s := range strings.Split(pkgPath, ".") {
-		fpath = filepath.Join(fpath, s)
+// appVersion prints the version of the project in the current folder or, with
+// bump as the first of args, increments it and upgrades the project
+func appVersion(args []string) error {
+	fs := flag.NewFlagSet("version", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "Optional. Print the changes without making them.")
+	bump := len(args) > 0 && args[0] == "bump"
+	if bump {
+		args = args[1:]
 	}
-	fpath = filepath.Join(fpath, "Main.java")
-	modFile(fpath, mainDotJava)
+	fs.Parse(args)
 
-	// and generate backend.aar
-	if out, err := exec.Command("gomobile", "bind", "-o", filepath.Join(outPath, "libs", "backend.aar"), ".").CombinedOutput(); err != nil {
-		exitError(fmt.Errorf("could not generate libs/backend.aar: %s: %s", err, out))
+	cfg, err := projectConfig(nil)
+	if err != nil {
+		return err
+	}
+	if !bump {
+		if fs.NArg() > 0 {
+			return fmt.Errorf("unknown version command %s", fs.Arg(0))
+		}
+		fmt.Printf("%s (%d)\n", cfg.Version, cfg.VersionCode)
+		return nil
 	}
 
-	// write a gitignore so we don't checkin local properties or build files
-	if err := ioutil.WriteFile(filepath.Join(outPath, ".gitignore"), []byte(gitignore), 0644); err != nil {
-		exitError(fmt.Errorf("error writing .gitignore:%s", err))
+	part := generator.Patch
+	if fs.NArg() > 0 {
+		part = fs.Arg(0)
 	}
+	if cfg, err = generator.BumpVersion(cfg, part); err != nil {
+		return err
+	}
+	fmt.Printf("version %s (%d)\n", cfg.Version, cfg.VersionCode)
+	return runUpgrade(cfg, *dryRun)
 }
 
-func javaImportPath(goImportPath string) string {
-	parts := strings.Split(goImportPath, "/")
-	domainparts := strings.Split(parts[0], ".")
-	ret := ""
-	for _, domainpart := range domainparts {
-		ret = domainpart + "." + ret
+// runUpgrade upgrades the project in the current folder to cfg and prints the
+// changes and conflicts. With dryRun the changes are printed as diffs
+// without making them.
+func runUpgrade(cfg generator.Config, dryRun bool) error {
+	fs := projectFS(dryRun)
+	res, err := generator.Upgrade(context.Background(), cfg, fs)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		if err := printChanges(fs); err != nil {
+			return err
+		}
+	} else {
+		for _, fpath := range res.Added {
+			fmt.Printf("added %s\n", fpath)
+		}
+		for _, fpath := range res.Updated {
+			fmt.Printf("updated %s\n", fpath)
+		}
+		for _, fpath := range res.Removed {
+			fmt.Printf("removed %s\n", fpath)
+		}
+	}
+	for _, c := range res.Conflicts {
+		fmt.Printf("conflict %s: %s\n", c.Path, c.Reason)
+		if c.MergePath != "" {
+			fmt.Printf("merged text written to %s\n", c.MergePath)
+		}
+		printConflicts(c.Merged)
+	}
+	if len(res.Conflicts) > 0 {
+		return fmt.Errorf("%d files were left untouched due to conflicts. Resolve them by hand using the changes printed above and run upgrade again", len(res.Conflicts))
 	}
-	ret = ret[:len(ret)-1]
+	return nil
+}
 
-	for _, part := range parts[1:] {
-		ret = ret + "." + part
+// printConflicts prints the conflicting regions of merged with a line of
+// context and their line numbers
+func printConflicts(merged []byte) {
+	lines := strings.Split(string(merged), "\n")
+	in := false
+	for j, line := range lines {
+		start := strings.HasPrefix(line, "<<<<<<< ")
+		if start && j > 0 && !in {
+			fmt.Printf("%5d  %s\n", j, lines[j-1])
+		}
+		if start {
+			in = true
+		}
+		if in {
+			fmt.Printf("%5d  %s\n", j+1, line)
+		}
+		if strings.HasPrefix(line, ">>>>>>> ") {
+			in = false
+			if j+1 < len(lines) {
+				fmt.Printf("%5d  %s\n", j+2, lines[j+1])
+			}
+			fmt.Println()
+		}
 	}
-	return ret
 }
 
 func main() {
-	flag.Parse()
-
-	switch target {
-	case "android":
-		genAndroid()
-	default:
-		exitError(fmt.Errorf("-target must be android or ios. Received %s", target))
+	err := run(os.Args[1:])
+	if code, ok := err.(exitCode); ok {
+		os.Exit(int(code))
+	}
+	if err != nil {
+		log.Printf("Error: %s\n", err)
+		log.Fatalln("For usage details, run mobilehtml5app -help")
 	}
 }
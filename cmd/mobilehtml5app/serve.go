@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"text/template"
+)
+
+// serve builds the webapp package in the current folder into a temporary
+// main program using the preview package and runs it. It returns the exit
+// status of the program as an exitCode if it fails.
+func serve(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	tags := fs.String("tags", "", "Optional. Build tags to use when building the webapp, eg. dev.")
+	datadir := fs.String("data", "", "Optional. Data folder passed to NewApp. A temporary folder which is removed on exit is used if omitted.")
+	lan := fs.String("lan", "", "Optional. Address such as :8080 to expose the App on for other devices on the network. Requires a token printed on startup.")
+	qr := fs.Bool("qr", false, "Optional. Print a QR code of the LAN URL. Requires -lan.")
+	fs.Parse(args)
+
+	out, err := exec.Command("go", "list", "-f", "{{.ImportPath}}", ".").CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("could not determine the package import path: %s: %s", err, out)
+	}
+
+	src, err := previewMain(strings.TrimSpace(string(out)))
+	if err != nil {
+		return err
+	}
+	tmpdir, err := ioutil.TempDir("", "mobilehtml5app-serve")
+	if err != nil {
+		return fmt.Errorf("could not create temporary folder: %s", err)
+	}
+	defer os.RemoveAll(tmpdir)
+	mainfile := filepath.Join(tmpdir, "main.go")
+	if err := ioutil.WriteFile(mainfile, src, 0644); err != nil {
+		return fmt.Errorf("error writing %s: %s", mainfile, err)
+	}
+
+	runargs := []string{"run"}
+	if *tags != "" {
+		runargs = append(runargs, "-tags", *tags)
+	}
+	runargs = append(runargs, mainfile)
+	if *datadir != "" {
+		runargs = append(runargs, "-data", *datadir)
+	}
+	if *lan != "" {
+		runargs = append(runargs, "-lan", *lan)
+	}
+	if *qr {
+		runargs = append(runargs, "-qr")
+	}
+
+	cmd := exec.Command("go", runargs...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	// Ctrl+C reaches go run and the app through the foreground process group.
+	// serve ignores it so that it outlives them to remove tmpdir and pass on
+	// their exit status.
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt)
+	err = cmd.Run()
+	signal.Stop(sigs)
+	if exit, ok := err.(*exec.ExitError); ok {
+		return exitCode(exitStatus(exit))
+	}
+	if err != nil {
+		return fmt.Errorf("could not run the webapp: %s", err)
+	}
+	return nil
+}
+
+// exitStatus returns the exit status of the process exit or 1 if it was
+// killed by a signal
+func exitStatus(exit *exec.ExitError) int {
+	if status, ok := exit.Sys().(syscall.WaitStatus); ok && status.ExitStatus() > 0 {
+		return status.ExitStatus()
+	}
+	return 1
+}
+
+func previewMain(importPath string) ([]byte, error) {
+	buf := bytes.Buffer{}
+	if err := previewMainTmpl.Execute(&buf, importPath); err != nil {
+		return nil, fmt.Errorf("error generating preview main: %s", err)
+	}
+	return buf.Bytes(), nil
+}
+
+var previewMainTmpl = template.Must(template.New("previewMain").Parse(`// Code generated by mobilehtml5app serve. DO NOT EDIT.
+
+package main
+
+import (
+	"log"
+
+	webapp "{{.}}"
+	"github.com/srinathh/mobilehtml5app/preview"
+)
+
+func main() {
+	if err := preview.Run(webapp.NewApp); err != nil {
+		log.Fatal(err)
+	}
+}
+`))
@@ -0,0 +1,77 @@
+// Package api is used to test tsgen
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/srinathh/mobilehtml5app/contextrouter"
+	"github.com/srinathh/mobilehtml5app/rpc"
+	"golang.org/x/net/context"
+)
+
+type priority int
+
+type meta struct {
+	Created time.Time
+	Tags    map[string]bool `json:"tags,omitempty"`
+}
+
+type item struct {
+	meta
+	ID       string `json:"id"`
+	Text     string
+	Priority priority
+	Parent   *item
+	Children []*item
+	Extra    json.RawMessage
+	Count    int64 `json:",string"`
+	Data     []byte
+	Ignored  string `json:"-"`
+	internal string
+}
+
+type deleteArgs struct {
+	IDs []string
+}
+
+func register(s *rpc.Server) {
+	s.Register("items.create", func(c context.Context, i item) (item, error) { return i, nil })
+	s.Register("items.list", func(c context.Context) ([]item, error) { return nil, nil })
+	s.Register("items.delete", func(c context.Context, args deleteArgs) (struct{ Deleted int }, error) {
+		return struct{ Deleted int }{len(args.IDs)}, nil
+	})
+	s.Register("ping", func(c context.Context) (string, error) { return "pong", nil })
+}
+
+type app struct{}
+
+func (a *app) listItems(c context.Context, w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode([]item{})
+}
+
+func (a *app) createItem(c context.Context, w http.ResponseWriter, r *http.Request) {
+	var i item
+	json.Unmarshal([]byte(r.PostFormValue("data")), &i)
+}
+
+func (a *app) index(c context.Context, w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte("<html></html>"))
+}
+
+func logger(fn contextrouter.ContextHandlerFunc) contextrouter.ContextHandlerFunc {
+	return fn
+}
+
+func routes(router *contextrouter.ContextRouter, a *app) {
+	router.HandleFunc(contextrouter.GET, "/", a.index)
+	router.HandleFunc(contextrouter.GET, "/items", logger(a.listItems))
+	router.HandleFunc(contextrouter.POST, "/items/new", logger(a.createItem))
+	router.Handle(contextrouter.PUT, "/items/:itemid", contextrouter.ContextHandlerFunc(func(c context.Context, w http.ResponseWriter, r *http.Request) {
+		var args deleteArgs
+		json.NewDecoder(r.Body).Decode(&args)
+		b, _ := json.Marshal(struct{ Deleted int }{})
+		w.Write(b)
+	}))
+}
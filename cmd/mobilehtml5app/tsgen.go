@@ -0,0 +1,819 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/constant"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/srinathh/mobilehtml5app/rpc"
+)
+
+// Import paths of the packages whose Server.Register and ContextRouter.Handle
+// calls tsgen looks for
+const (
+	rpcPkgPath           = "github.com/srinathh/mobilehtml5app/rpc"
+	contextrouterPkgPath = "github.com/srinathh/mobilehtml5app/contextrouter"
+)
+
+// tsgen writes TypeScript declarations for the argument and result types of
+// the functions registered with rpc.Server.Register and of the JSON handled by
+// the routes of the ContextRouter in the package in the current folder along
+// with a typed client calling them
+func tsgen(args []string) error {
+	fs := flag.NewFlagSet("tsgen", flag.ExitOnError)
+	out := fs.String("o", ".", "Optional. Folder to write types.d.ts and client.ts to.")
+	tags := fs.String("tags", "", "Optional. Comma separated build tags to use when loading the webapp package.")
+	fs.Parse(args)
+
+	methods, routes, err := loadAPI(".", splitTags(*tags))
+	if err != nil {
+		return err
+	}
+	if len(methods) == 0 && len(routes) == 0 {
+		return fmt.Errorf("no functions registered with rpc.Server.Register or routes handling JSON found")
+	}
+
+	typesTS, clientTS, err := genTypeScript(methods, routes)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(*out, 0755); err != nil {
+		return fmt.Errorf("could not create %s: %s", *out, err)
+	}
+	for fname, b := range map[string][]byte{"types.d.ts": typesTS, "client.ts": clientTS} {
+		if err := ioutil.WriteFile(filepath.Join(*out, fname), b, 0644); err != nil {
+			return fmt.Errorf("error writing %s: %s", fname, err)
+		}
+	}
+	return nil
+}
+
+// rpcMethod is a function registered with rpc.Server.Register
+type rpcMethod struct {
+	Name   string
+	Args   types.Type // nil if the function takes no argument
+	Result types.Type
+}
+
+// splitTags splits the comma separated build tags of -tags like the go
+// command does
+func splitTags(tags string) []string {
+	var ret []string
+	for _, tag := range strings.Split(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			ret = append(ret, tag)
+		}
+	}
+	return ret
+}
+
+// route is a route registered with contextrouter.ContextRouter.Handle or
+// HandleFunc whose handler decodes or encodes JSON
+type route struct {
+	Method   string
+	Path     string
+	Request  types.Type // nil if the handler decodes no JSON
+	Response types.Type // nil if the handler encodes no JSON
+	// Form is the form field the request is decoded from. The request is
+	// sent as the body if empty.
+	Form string
+}
+
+// loadAPI type checks the package in dir and returns the functions
+// registered in it with a constant name sorted by name along with the routes
+// with constant methods and paths handling JSON sorted by path
+func loadAPI(dir string, tags []string) ([]rpcMethod, []route, error) {
+	// the source importer loads the dependencies with build.Default so the
+	// tags must be set there for tag gated types to resolve the same way
+	defer func(saved []string) { build.Default.BuildTags = saved }(build.Default.BuildTags)
+	build.Default.BuildTags = tags
+	bpkg, err := build.Default.ImportDir(dir, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load package in %s: %s", dir, err)
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, fname := range bpkg.GoFiles {
+		f, err := parser.ParseFile(fset, filepath.Join(dir, fname), nil, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, f)
+	}
+
+	info := &types.Info{
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
+		Defs:       make(map[*ast.Ident]types.Object),
+		Uses:       make(map[*ast.Ident]types.Object),
+	}
+	pkgPath := bpkg.ImportPath
+	if pkgPath == "" || pkgPath == "." {
+		// outside GOPATH and modules
+		pkgPath = bpkg.Name
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := conf.Check(pkgPath, fset, files, info); err != nil {
+		return nil, nil, fmt.Errorf("could not type check package in %s: %s", dir, err)
+	}
+
+	methods, err := findRPCMethods(fset, files, info)
+	if err != nil {
+		return nil, nil, err
+	}
+	return methods, findRoutes(files, info), nil
+}
+
+// findRPCMethods returns the functions registered with rpc.Server.Register in
+// files sorted by name
+func findRPCMethods(fset *token.FileSet, files []*ast.File, info *types.Info) ([]rpcMethod, error) {
+	var methods []rpcMethod
+	var errs []string
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) != 2 {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "Register" || !isMethodOf(info.Selections[sel], rpcPkgPath, "Server") {
+				return true
+			}
+
+			pos := fset.Position(call.Pos())
+			name := info.Types[call.Args[0]].Value
+			if name == nil || name.Kind() != constant.String {
+				errs = append(errs, fmt.Sprintf("%s: method name must be a constant string", pos))
+				return true
+			}
+			sig, ok := info.Types[call.Args[1]].Type.Underlying().(*types.Signature)
+			if !ok || sig.Params().Len() < 1 || sig.Params().Len() > 2 || sig.Results().Len() != 2 {
+				errs = append(errs, fmt.Sprintf("%s: unsupported function for %s", pos, name))
+				return true
+			}
+
+			m := rpcMethod{Name: constant.StringVal(name), Result: sig.Results().At(0).Type()}
+			if sig.Params().Len() == 2 {
+				m.Args = sig.Params().At(1).Type()
+			}
+			methods = append(methods, m)
+			return true
+		})
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	sort.Sort(rpcMethodSorter(methods))
+	return methods, nil
+}
+
+type rpcMethodSorter []rpcMethod
+
+func (s rpcMethodSorter) Len() int           { return len(s) }
+func (s rpcMethodSorter) Less(i, j int) bool { return s[i].Name < s[j].Name }
+func (s rpcMethodSorter) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// isMethodOf reports whether sel selects a method of the type typeName of the
+// package pkgPath
+func isMethodOf(sel *types.Selection, pkgPath, typeName string) bool {
+	if sel == nil || sel.Kind() != types.MethodVal {
+		return false
+	}
+	recv := sel.Recv()
+	if p, ok := recv.(*types.Pointer); ok {
+		recv = p.Elem()
+	}
+	named, ok := recv.(*types.Named)
+	return ok && named.Obj().Name() == typeName && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == pkgPath
+}
+
+// findRoutes returns the routes registered with ContextRouter.Handle or
+// HandleFunc in files whose handlers, declared in files, decode or encode JSON
+// sorted by path
+func findRoutes(files []*ast.File, info *types.Info) []route {
+	decls := make(map[types.Object]*ast.FuncDecl)
+	for _, f := range files {
+		for _, d := range f.Decls {
+			if fd, ok := d.(*ast.FuncDecl); ok && fd.Body != nil {
+				decls[info.Defs[fd.Name]] = fd
+			}
+		}
+	}
+
+	var routes []route
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) != 3 {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || (sel.Sel.Name != "Handle" && sel.Sel.Name != "HandleFunc") || !isMethodOf(info.Selections[sel], contextrouterPkgPath, "ContextRouter") {
+				return true
+			}
+			// routes registered with computed methods or paths are skipped
+			// like those of handlers that don't handle JSON
+			method, path := info.Types[call.Args[0]].Value, info.Types[call.Args[1]].Value
+			if method == nil || method.Kind() != constant.String || path == nil || path.Kind() != constant.String {
+				return true
+			}
+			body := handlerBody(call.Args[2], info, decls)
+			if body == nil {
+				return true
+			}
+			r := jsonRoute(body, info)
+			if r.Request == nil && r.Response == nil {
+				return true
+			}
+			r.Method, r.Path = constant.StringVal(method), constant.StringVal(path)
+			routes = append(routes, r)
+			return true
+		})
+	}
+	sort.Sort(routeSorter(routes))
+	return routes
+}
+
+type routeSorter []route
+
+func (s routeSorter) Len() int { return len(s) }
+func (s routeSorter) Less(i, j int) bool {
+	if s[i].Path != s[j].Path {
+		return s[i].Path < s[j].Path
+	}
+	return s[i].Method < s[j].Method
+}
+func (s routeSorter) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+// handlerBody returns the body of the handler expr passed to Handle or
+// HandleFunc. It looks through conversions such as ContextHandlerFunc(fn) and
+// wrappers such as logger(fn) taking the handler as an argument.
+func handlerBody(expr ast.Expr, info *types.Info, decls map[types.Object]*ast.FuncDecl) *ast.BlockStmt {
+	switch e := expr.(type) {
+	case *ast.FuncLit:
+		return e.Body
+	case *ast.ParenExpr:
+		return handlerBody(e.X, info, decls)
+	case *ast.Ident:
+		if d, ok := decls[info.Uses[e]]; ok {
+			return d.Body
+		}
+	case *ast.SelectorExpr:
+		obj := info.Uses[e.Sel]
+		if sel := info.Selections[e]; sel != nil {
+			obj = sel.Obj()
+		}
+		if d, ok := decls[obj]; ok {
+			return d.Body
+		}
+	case *ast.CallExpr:
+		for _, arg := range e.Args {
+			if body := handlerBody(arg, info, decls); body != nil {
+				return body
+			}
+		}
+	}
+	return nil
+}
+
+// jsonRoute returns the types body decodes from the request and encodes into
+// the response with encoding/json. The first Decode or Unmarshal and the
+// first Encode or Marshal are taken.
+func jsonRoute(body *ast.BlockStmt, info *types.Info) route {
+	var r route
+	// valueType returns the type of the value a pointer argument points to
+	valueType := func(arg ast.Expr) types.Type {
+		t := info.Types[arg].Type
+		if p, ok := t.(*types.Pointer); ok {
+			return p.Elem()
+		}
+		return t
+	}
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		switch {
+		case r.Request == nil && len(call.Args) == 1 && sel.Sel.Name == "Decode" && isMethodOf(info.Selections[sel], "encoding/json", "Decoder"):
+			r.Request = valueType(call.Args[0])
+		case r.Request == nil && len(call.Args) == 2 && isFunc(info.Uses[sel.Sel], "encoding/json", "Unmarshal"):
+			r.Request = valueType(call.Args[1])
+			r.Form = formField(call.Args[0], info)
+		case r.Response == nil && len(call.Args) == 1 && sel.Sel.Name == "Encode" && isMethodOf(info.Selections[sel], "encoding/json", "Encoder"):
+			r.Response = info.Types[call.Args[0]].Type
+		case r.Response == nil && len(call.Args) == 1 && isFunc(info.Uses[sel.Sel], "encoding/json", "Marshal"):
+			r.Response = info.Types[call.Args[0]].Type
+		}
+		return true
+	})
+	return r
+}
+
+// isFunc reports whether obj is the function name of the package pkgPath
+func isFunc(obj types.Object, pkgPath, name string) bool {
+	fn, ok := obj.(*types.Func)
+	return ok && fn.Name() == name && fn.Pkg() != nil && fn.Pkg().Path() == pkgPath
+}
+
+// formField returns the name of the form field data is read from if it is
+// []byte(r.FormValue(name)) or []byte(r.PostFormValue(name)) with a constant
+// name
+func formField(data ast.Expr, info *types.Info) string {
+	conv, ok := data.(*ast.CallExpr)
+	if !ok || len(conv.Args) != 1 || !info.Types[conv.Fun].IsType() {
+		return ""
+	}
+	call, ok := conv.Args[0].(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return ""
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "FormValue" && sel.Sel.Name != "PostFormValue") || !isMethodOf(info.Selections[sel], "net/http", "Request") {
+		return ""
+	}
+	name := info.Types[call.Args[0]].Value
+	if name == nil || name.Kind() != constant.String {
+		return ""
+	}
+	return constant.StringVal(name)
+}
+
+// tsTypes converts Go types to TypeScript following the rules of
+// encoding/json and collects declarations for the named types encountered
+type tsTypes struct {
+	names  map[*types.TypeName]string
+	used   map[string]bool
+	decls  []string
+	prefix string // qualifier for references to declared types
+}
+
+// ts returns the TypeScript type for t
+func (g *tsTypes) ts(t types.Type) string {
+	t = unalias(t)
+	if named, ok := t.(*types.Named); ok {
+		obj := named.Obj()
+		switch qualifiedName(obj) {
+		case "time.Time":
+			return "string"
+		case "encoding/json.RawMessage":
+			return "any"
+		}
+		// custom encodings can't be known so anything goes
+		mset := types.NewMethodSet(types.NewPointer(t))
+		if mset.Lookup(nil, "MarshalJSON") != nil {
+			return "any"
+		}
+		if mset.Lookup(nil, "MarshalText") != nil {
+			return "string"
+		}
+		if obj.Pkg() != nil {
+			return g.declare(named)
+		}
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			return "boolean"
+		case u.Info()&types.IsNumeric != 0:
+			return "number"
+		case u.Info()&types.IsString != 0:
+			return "string"
+		}
+	case *types.Pointer:
+		return g.ts(u.Elem()) + " | null"
+	case *types.Slice:
+		if b, ok := u.Elem().Underlying().(*types.Basic); ok && b.Kind() == types.Byte {
+			// encoded as base64
+			return "string"
+		}
+		return g.elem(u.Elem()) + "[]"
+	case *types.Array:
+		return g.elem(u.Elem()) + "[]"
+	case *types.Map:
+		return "{ [key: string]: " + g.ts(u.Elem()) + " }"
+	case *types.Struct:
+		return "{ " + strings.Join(g.fields(u), " ") + " }"
+	}
+	return "any"
+}
+
+// qualifiedName returns the import path qualified name of obj
+func qualifiedName(obj types.Object) string {
+	if obj.Pkg() == nil {
+		return obj.Name()
+	}
+	return obj.Pkg().Path() + "." + obj.Name()
+}
+
+// elem returns the TypeScript type for t for use as an array element
+func (g *tsTypes) elem(t types.Type) string {
+	s := g.ts(t)
+	if strings.Contains(s, "|") {
+		return "(" + s + ")"
+	}
+	return s
+}
+
+// declare returns the TypeScript name for the named type t adding a
+// declaration for it on first use
+func (g *tsTypes) declare(t *types.Named) string {
+	obj := t.Obj()
+	if name, ok := g.names[obj]; ok {
+		return g.prefix + name
+	}
+	name := obj.Name()
+	if g.used[name] {
+		pkg := obj.Pkg().Name()
+		name = strings.ToUpper(pkg[:1]) + pkg[1:] + name
+	}
+	g.names[obj] = name
+	g.used[name] = true
+
+	// declarations refer to each other unqualified
+	prefix := g.prefix
+	g.prefix = ""
+	defer func() { g.prefix = prefix }()
+
+	doc := fmt.Sprintf("// %s is %s\n", name, qualifiedName(obj))
+	if st, ok := t.Underlying().(*types.Struct); ok {
+		body := ""
+		for _, f := range g.fields(st) {
+			body += "    " + f + "\n"
+		}
+		g.decls = append(g.decls, fmt.Sprintf("%sexport interface %s {\n%s}\n", doc, name, body))
+	} else {
+		g.decls = append(g.decls, fmt.Sprintf("%sexport type %s = %s;\n", doc, name, g.ts(t.Underlying())))
+	}
+	return prefix + name
+}
+
+// fields returns the TypeScript property declarations for the fields of st
+// as encoded by encoding/json
+func (g *tsTypes) fields(st *types.Struct) []string {
+	var ret []string
+	for j := 0; j < st.NumFields(); j++ {
+		f := st.Field(j)
+		tag := reflect.StructTag(st.Tag(j)).Get("json")
+		if tag == "-" {
+			continue
+		}
+		opts := strings.Split(tag, ",")
+		name := opts[0]
+
+		if f.Anonymous() && name == "" {
+			ft := f.Type()
+			if p, ok := ft.(*types.Pointer); ok {
+				ft = p.Elem()
+			}
+			if embedded, ok := ft.Underlying().(*types.Struct); ok {
+				ret = append(ret, g.fields(embedded)...)
+				continue
+			}
+		}
+		if !f.Exported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name()
+		}
+
+		prop := name
+		if !tsIdent.MatchString(prop) {
+			prop = fmt.Sprintf("%q", prop)
+		}
+		optional := ""
+		typ := ""
+		for _, opt := range opts[1:] {
+			switch opt {
+			case "omitempty":
+				optional = "?"
+			case "string":
+				typ = "string"
+			}
+		}
+		if typ == "" {
+			typ = g.ts(f.Type())
+		}
+		ret = append(ret, fmt.Sprintf("%s%s: %s;", prop, optional, typ))
+	}
+	return ret
+}
+
+var tsIdent = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// tsNamespace is a level of the tree of dotted method names
+type tsNamespace struct {
+	key      string
+	children []*tsNamespace
+	method   string // method name if this is a leaf
+	params   string // TypeScript parameter list
+	result   string
+}
+
+func (ns *tsNamespace) child(key string) *tsNamespace {
+	for _, c := range ns.children {
+		if c.key == key && c.method == "" {
+			return c
+		}
+	}
+	c := &tsNamespace{key: key}
+	ns.children = append(ns.children, c)
+	return c
+}
+
+// write writes the members of the object literal for ns to buf
+func (ns *tsNamespace) write(buf *bytes.Buffer, indent string) {
+	for _, c := range ns.children {
+		key := c.key
+		if !tsIdent.MatchString(key) {
+			key = fmt.Sprintf("%q", key)
+		}
+		if c.method == "" {
+			fmt.Fprintf(buf, "%s%s: {\n", indent, key)
+			c.write(buf, indent+"    ")
+			fmt.Fprintf(buf, "%s},\n", indent)
+			continue
+		}
+		args := ""
+		if c.params != "" {
+			args = ", params"
+		}
+		fmt.Fprintf(buf, "%s%s(%s): Promise<%s> {\n", indent, key, c.params, c.result)
+		fmt.Fprintf(buf, "%s    return call<%s>(%q%s);\n", indent, c.result, c.method, args)
+		fmt.Fprintf(buf, "%s},\n", indent)
+	}
+}
+
+// routeFunc returns the name of the client function for r such as getItems
+// for GET /items or getItemsByID for GET /items/:id
+func routeFunc(r route) string {
+	name := strings.ToLower(r.Method)
+	for _, seg := range strings.Split(r.Path, "/") {
+		if strings.HasPrefix(seg, ":") || strings.HasPrefix(seg, "*") {
+			name += "By"
+			seg = seg[1:]
+		}
+		name += camel(seg)
+	}
+	if name == strings.ToLower(r.Method) {
+		name += "Index"
+	}
+	return name
+}
+
+// camel joins the alphanumeric words of s starting each with an upper case
+// letter
+func camel(s string) string {
+	ret := ""
+	for _, word := range nonAlnum.Split(s, -1) {
+		if word != "" {
+			ret += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return ret
+}
+
+// lowerCamel is camel starting with a lower case letter
+func lowerCamel(s string) string {
+	s = camel(s)
+	if s == "" {
+		return "param"
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+var nonAlnum = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// writeRoute writes the member of the routes object literal calling r named
+// name to buf
+func (g *tsTypes) writeRoute(buf *bytes.Buffer, indent, name string, r route) {
+	var params, path []string
+	for j, seg := range strings.Split(r.Path, "/") {
+		sep := "/"
+		if j == 0 {
+			sep = ""
+		}
+		switch {
+		case strings.HasPrefix(seg, ":"):
+			param := lowerCamel(seg[1:])
+			params = append(params, param+": string")
+			path = append(path, fmt.Sprintf("%q", sep), "encodeURIComponent("+param+")")
+		case strings.HasPrefix(seg, "*"):
+			// catch-all parameters start with a slash
+			param := lowerCamel(seg[1:])
+			params = append(params, param+": string")
+			path = append(path, "encodeURI("+param+")")
+		default:
+			path = append(path, fmt.Sprintf("%q", sep+seg))
+		}
+	}
+	// merge adjacent literals
+	expr := ""
+	for _, p := range path {
+		if expr != "" && strings.HasSuffix(expr, `"`) && strings.HasPrefix(p, `"`) {
+			expr = expr[:len(expr)-1] + p[1:]
+			continue
+		}
+		if expr != "" {
+			expr += " + "
+		}
+		expr += p
+	}
+
+	var opts []string
+	if r.Request != nil {
+		params = append(params, "params: "+g.ts(r.Request))
+		opts = append(opts, "params: params")
+		if r.Form != "" {
+			opts = append(opts, fmt.Sprintf("form: %q", r.Form))
+		}
+	}
+	result := "void"
+	if r.Response != nil {
+		result = g.ts(r.Response)
+		opts = append(opts, "decode: true")
+	}
+	args := ""
+	if len(opts) > 0 {
+		args = ", {" + strings.Join(opts, ", ") + "}"
+	}
+	fmt.Fprintf(buf, "%s// %s %s\n", indent, r.Method, r.Path)
+	fmt.Fprintf(buf, "%s%s(%s): Promise<%s> {\n", indent, name, strings.Join(params, ", "), result)
+	fmt.Fprintf(buf, "%s    return request<%s>(%q, %s%s);\n", indent, result, r.Method, expr, args)
+	fmt.Fprintf(buf, "%s},\n", indent)
+}
+
+// genTypeScript returns the contents of types.d.ts and client.ts for methods
+// and routes
+func genTypeScript(methods []rpcMethod, routes []route) ([]byte, []byte, error) {
+	g := &tsTypes{
+		names:  make(map[*types.TypeName]string),
+		used:   make(map[string]bool),
+		prefix: "types.",
+	}
+
+	root := &tsNamespace{}
+	for _, m := range methods {
+		parts := strings.Split(m.Name, ".")
+		ns := root
+		for _, part := range parts[:len(parts)-1] {
+			ns = ns.child(part)
+		}
+		leaf := ns.child(parts[len(parts)-1])
+		leaf.method = m.Name
+		leaf.result = g.ts(m.Result)
+		if m.Args != nil {
+			leaf.params = "params: " + g.ts(m.Args)
+		}
+	}
+
+	// route types are declared before types.d.ts is written
+	routeMembers := bytes.Buffer{}
+	names := make(map[string]bool)
+	for _, r := range routes {
+		name := routeFunc(r)
+		for j := 2; names[name]; j++ {
+			name = fmt.Sprintf("%s%d", routeFunc(r), j)
+		}
+		names[name] = true
+		g.writeRoute(&routeMembers, "    ", name, r)
+	}
+
+	typesTS := bytes.Buffer{}
+	typesTS.WriteString("// Code generated by mobilehtml5app tsgen. DO NOT EDIT.\n")
+	for _, decl := range g.decls {
+		typesTS.WriteString("\n" + decl)
+	}
+	if len(g.decls) == 0 {
+		typesTS.WriteString("\nexport {};\n")
+	}
+
+	members := bytes.Buffer{}
+	root.write(&members, "    ")
+	clientTS := bytes.Buffer{}
+	if err := tsClientTmpl.Execute(&clientTS, struct {
+		Path         string
+		Members      string
+		RouteMembers string
+	}{rpc.Path, members.String(), routeMembers.String()}); err != nil {
+		return nil, nil, fmt.Errorf("error generating client: %s", err)
+	}
+	return typesTS.Bytes(), clientTS.Bytes(), nil
+}
+
+var tsClientTmpl = template.Must(template.New("client").Parse(`// Code generated by mobilehtml5app tsgen. DO NOT EDIT.
+
+import * as types from "./types";
+
+export class RPCError extends Error {
+    constructor(public code: number, message: string, public data?: any) {
+        super(message);
+    }
+}
+
+let nextID = 1;
+
+// call uses XMLHttpRequest rather than fetch which older Android WebViews lack
+export function call<T>(method: string, params?: any): Promise<T> {
+    return new Promise<T>((resolve, reject) => {
+        const xhr = new XMLHttpRequest();
+        xhr.open("POST", "{{.Path}}");
+        xhr.setRequestHeader("Content-Type", "application/json");
+        xhr.onload = () => {
+            let res: any;
+            try {
+                res = JSON.parse(xhr.responseText);
+            } catch (e) {
+                reject(new Error("rpc: invalid response with status " + xhr.status));
+                return;
+            }
+            if (res.error) {
+                reject(new RPCError(res.error.code, res.error.message, res.error.data));
+                return;
+            }
+            resolve(res.result as T);
+        };
+        xhr.onerror = () => reject(new Error("rpc: request failed"));
+        xhr.send(JSON.stringify({jsonrpc: "2.0", method: method, params: params, id: nextID++}));
+    });
+}
+
+export const rpc = {
+{{.Members}}};
+
+// RequestError is the error of a route responding with an HTTP error status
+export class RequestError extends Error {
+    constructor(public status: number, message: string) {
+        super(message);
+    }
+}
+
+export interface RequestOptions {
+    // params are sent as the JSON body or in the form field form
+    params?: any;
+    form?: string;
+    // decode decodes the response as JSON
+    decode?: boolean;
+}
+
+// request calls a route of the ContextRouter of the webapp
+export function request<T>(method: string, path: string, opts: RequestOptions = {}): Promise<T> {
+    return new Promise<T>((resolve, reject) => {
+        const xhr = new XMLHttpRequest();
+        xhr.open(method, path);
+        let body: string | null = null;
+        if (opts.params !== undefined) {
+            if (opts.form) {
+                xhr.setRequestHeader("Content-Type", "application/x-www-form-urlencoded");
+                body = encodeURIComponent(opts.form) + "=" + encodeURIComponent(JSON.stringify(opts.params));
+            } else {
+                xhr.setRequestHeader("Content-Type", "application/json");
+                body = JSON.stringify(opts.params);
+            }
+        }
+        xhr.onload = () => {
+            if (xhr.status >= 400) {
+                reject(new RequestError(xhr.status, xhr.responseText || xhr.statusText));
+                return;
+            }
+            if (!opts.decode) {
+                resolve(undefined as any);
+                return;
+            }
+            try {
+                resolve(JSON.parse(xhr.responseText) as T);
+            } catch (e) {
+                reject(new Error(method + " " + path + ": invalid response"));
+            }
+        };
+        xhr.onerror = () => reject(new Error(method + " " + path + ": request failed"));
+        xhr.send(body);
+    });
+}
+
+export const routes = {
+{{.RouteMembers}}};
+`))
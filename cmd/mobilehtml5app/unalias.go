@@ -0,0 +1,12 @@
+//go:build !go1.22
+// +build !go1.22
+
+package main
+
+import "go/types"
+
+// unalias returns t since go/types resolves aliases to the types they denote
+// before Go 1.22
+func unalias(t types.Type) types.Type {
+	return t
+}
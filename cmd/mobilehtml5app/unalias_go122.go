@@ -0,0 +1,12 @@
+//go:build go1.22
+// +build go1.22
+
+package main
+
+import "go/types"
+
+// unalias returns the type t denotes if it is an alias. Since Go 1.22
+// go/types may represent aliases as *types.Alias.
+func unalias(t types.Type) types.Type {
+	return types.Unalias(t)
+}
@@ -0,0 +1,156 @@
+package contextrouter
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/julienschmidt/httprouter"
+	"golang.org/x/net/context"
+)
+
+// ErrNoLink is returned by LinkRouter.Resolve for links not matching any
+// pattern when no NotFound handler is set
+var ErrNoLink = errors.New("no route for link")
+
+// LinkHandler returns the in-app URL the WebView should load for a deep link.
+// Named parameters of the matched pattern are passed via the Context as with
+// ContextHandler.
+type LinkHandler func(c context.Context, link *url.URL) (string, error)
+
+// LinkRouter resolves deep links such as myapp://items/123 or app links such
+// as https://example.com/items/123 handed over by the native portion of the
+// app from VIEW intents to the in-app URL the WebView should load. Patterns
+// are URLs whose path uses the same named and catch-all parameters as
+// ContextRouter:
+//
+//	links := contextrouter.NewLinkRouter()
+//	links.Handle("myapp://items/:itemid", contextrouter.LinkTo("/items/:itemid"))
+//	links.Handle("https://example.com/items/:itemid", contextrouter.LinkTo("/items/:itemid"))
+//	links.NotFound = contextrouter.LinkTo("/")
+//
+//	// ResolveLink is called by the native portion of the app for VIEW intents
+//	func (app *App) ResolveLink(rawurl string) (string, error) {
+//		return app.links.Resolve(rawurl)
+//	}
+type LinkRouter struct {
+	// NotFound is called for links not matching any pattern. Resolve returns
+	// ErrNoLink for such links if it is nil.
+	NotFound LinkHandler
+	router   *httprouter.Router
+	sync.RWMutex
+}
+
+// linkMatch is passed to the httprouter.Handle registered for a pattern to
+// retrieve the LinkHandler for the pattern matched by httprouter.Lookup
+type linkMatch struct {
+	http.ResponseWriter
+	handler LinkHandler
+}
+
+// NewLinkRouter returns a LinkRouter without any patterns.
+func NewLinkRouter() *LinkRouter {
+	return &LinkRouter{
+		router: httprouter.New(),
+	}
+}
+
+// Handle registers handler for links matching pattern. The scheme and host of
+// the pattern must match exactly while its path may contain parameters. It
+// panics if the pattern is not a URL with a scheme and host or conflicts with
+// a registered pattern.
+func (l *LinkRouter) Handle(pattern string, handler LinkHandler) {
+	u, err := url.Parse(pattern)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		panic("link pattern must be a URL with a scheme and host in '" + pattern + "'")
+	}
+	l.Lock()
+	defer l.Unlock()
+	l.router.Handle("GET", linkPath(u), func(w http.ResponseWriter, _ *http.Request, _ httprouter.Params) {
+		w.(*linkMatch).handler = handler
+	})
+}
+
+// Resolve returns the in-app URL for the deep link rawurl.
+func (l *LinkRouter) Resolve(rawurl string) (string, error) {
+	link, err := url.Parse(rawurl)
+	if err != nil {
+		return "", err
+	}
+
+	l.RLock()
+	handler, params := l.lookup(link)
+	if handler == nil {
+		handler = l.NotFound
+	}
+	l.RUnlock()
+	if handler == nil {
+		return "", ErrNoLink
+	}
+
+	c := context.Background()
+	for _, p := range params {
+		c = context.WithValue(c, p.Key, p.Value)
+	}
+	return handler(c, link)
+}
+
+// lookup returns the handler registered for link and its parameters or nil
+// if no pattern matches
+func (l *LinkRouter) lookup(link *url.URL) (LinkHandler, httprouter.Params) {
+	if link.Scheme == "" || link.Host == "" {
+		return nil, nil
+	}
+	p := linkPath(link)
+	h, params, tsr := l.router.Lookup("GET", p)
+	if h == nil && tsr {
+		// myapp://items and myapp://items/ are the same link
+		if strings.HasSuffix(p, "/") {
+			p = p[:len(p)-1]
+		} else {
+			p = p + "/"
+		}
+		h, params, _ = l.router.Lookup("GET", p)
+	}
+	if h == nil {
+		return nil, nil
+	}
+	m := &linkMatch{}
+	h(m, nil, params)
+	return m.handler, params
+}
+
+// linkPath maps the scheme, host and path of u to a single path so that all
+// parts of links are matched by the httprouter
+func linkPath(u *url.URL) string {
+	return "/" + strings.ToLower(u.Scheme) + "/" + strings.ToLower(u.Host) + u.Path
+}
+
+// LinkTo returns a LinkHandler resolving links to target with named and
+// catch-all parameters in target replaced by their values for the link. The
+// query of the link is appended if target has none.
+//
+//	links.Handle("myapp://items/:itemid", contextrouter.LinkTo("/items/:itemid"))
+func LinkTo(target string) LinkHandler {
+	return func(c context.Context, link *url.URL) (string, error) {
+		parts := strings.Split(target, "/")
+		for j, part := range parts {
+			if len(part) > 1 && (part[0] == ':' || part[0] == '*') {
+				if v, ok := c.Value(part[1:]).(string); ok {
+					segs := strings.Split(strings.TrimPrefix(v, "/"), "/")
+					for k := range segs {
+						segs[k] = url.PathEscape(segs[k])
+					}
+					parts[j] = strings.Join(segs, "/")
+				}
+			}
+		}
+		ret := strings.Join(parts, "/")
+		if link.RawQuery != "" && !strings.Contains(ret, "?") {
+			ret += "?" + link.RawQuery
+		}
+		return ret, nil
+	}
+}
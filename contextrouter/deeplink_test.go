@@ -0,0 +1,55 @@
+package contextrouter
+
+import (
+	"net/url"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestLinkRouter(t *testing.T) {
+	links := NewLinkRouter()
+	links.Handle("myapp://items/:itemid", LinkTo("/items/:itemid"))
+	links.Handle("https://example.com/items/:itemid", LinkTo("/items/:itemid"))
+	links.Handle("https://example.com/docs/*path", LinkTo("/help/*path"))
+	links.Handle("myapp://settings", func(c context.Context, link *url.URL) (string, error) {
+		return "/settings#" + link.Query().Get("section"), nil
+	})
+
+	tests := []struct {
+		link, want string
+	}{
+		{"myapp://items/123", "/items/123"},
+		{"MyApp://items/123?tab=notes", "/items/123?tab=notes"},
+		{"https://Example.com/items/a%20b", "/items/a%20b"},
+		{"https://example.com/docs/getting/started", "/help/getting/started"},
+		{"myapp://settings?section=sync", "/settings#sync"},
+		{"myapp://settings/", "/settings#"},
+	}
+	for _, test := range tests {
+		got, err := links.Resolve(test.link)
+		if err != nil || got != test.want {
+			t.Errorf("for %s want %s got %s %v", test.link, test.want, got, err)
+		}
+	}
+
+	for _, link := range []string{"myapp://items", "otherapp://items/123", "https://example.org/items/1", "mailto:a@example.com", "/items/123"} {
+		if got, err := links.Resolve(link); err != ErrNoLink {
+			t.Errorf("for %s want ErrNoLink got %s %v", link, got, err)
+		}
+	}
+
+	links.NotFound = func(c context.Context, link *url.URL) (string, error) {
+		return "/?unknown=" + url.QueryEscape(link.String()), nil
+	}
+	if got, err := links.Resolve("otherapp://x"); err != nil || got != "/?unknown=otherapp%3A%2F%2Fx" {
+		t.Errorf("want NotFound handler result got %s %v", got, err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("want panic for pattern without a scheme")
+		}
+	}()
+	links.Handle("/items/:itemid", LinkTo("/"))
+}
@@ -3,6 +3,8 @@ package contextrouter
 import (
 	"net/http"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/julienschmidt/httprouter"
 	"golang.org/x/net/context"
@@ -51,6 +53,16 @@ type ContextRouter struct {
 	router     *httprouter.Router
 	context    context.Context
 	cancelfunc context.CancelFunc
+	// closeTimeout is the time.Duration given to WebSocket connections to
+	// send their close frame on Stop. It is read by handlers holding the
+	// read lock and so is accessed atomically.
+	closeTimeout int64
+	// runs counts the calls to Run in progress. Run reads the context under
+	// runMu rather than the read lock so that it can be called from
+	// handlers, which already hold the read lock, while Stop is waiting.
+	runs  int
+	runMu sync.Mutex
+	idle  *sync.Cond
 	sync.RWMutex
 }
 
@@ -58,11 +70,13 @@ type ContextRouter struct {
 func New() *ContextRouter {
 	ctx, cfunc := context.WithCancel(context.Background())
 	// corresponding cancelfunc will ne created on Start()
-	return &ContextRouter{
+	s := &ContextRouter{
 		context:    ctx,
 		cancelfunc: cfunc,
 		router:     httprouter.New(),
 	}
+	s.idle = sync.NewCond(&s.runMu)
+	return s
 }
 
 // Handle registers a ContextHandler for the required method and route.
@@ -79,6 +93,23 @@ func (s *ContextRouter) HandleFunc(method Method, path string, handler func(cont
 	s.Handle(method, path, ContextHandlerFunc(handler))
 }
 
+// ServeFiles serves files from the given file system root using http.FileServer.
+// The path must end with "/*filepath" and files are then served from the
+// corresponding location in root. Use http.Dir to serve a folder on disk or
+// assets.OpenZip to serve the assets folder of the APK.
+//
+//	router.ServeFiles("/res/*filepath", http.Dir("/sdcard/res"))
+func (s *ContextRouter) ServeFiles(path string, root http.FileSystem) {
+	if len(path) < 10 || path[len(path)-10:] != "/*filepath" {
+		panic("path must end with /*filepath in path '" + path + "'")
+	}
+	fileServer := http.FileServer(root)
+	s.HandleFunc(GET, path, func(c context.Context, w http.ResponseWriter, r *http.Request) {
+		r.URL.Path = c.Value("filepath").(string)
+		fileServer.ServeHTTP(w, r)
+	})
+}
+
 // wrapToHandle wraps ContextHandlers to the httprouter.Handle type using a
 // function closure which passes httprouter.Params as Context.Values to the
 // registered ContextHandlers
@@ -95,18 +126,55 @@ func (s *ContextRouter) wrapToHandle(handler ContextHandler) httprouter.Handle {
 	})
 }
 
+// Run calls fn with the root Context outside of any request, for instance to
+// run background work while the server isn't listening. Like handlers, fn
+// is signalled by the Done channel on Stop and Stop waits for it to return.
+// Run may be called from handlers. During Stop fn gets the closed Context.
+func (s *ContextRouter) Run(fn func(c context.Context)) {
+	s.runMu.Lock()
+	c := s.context
+	s.runs++
+	s.runMu.Unlock()
+
+	defer func() {
+		s.runMu.Lock()
+		s.runs--
+		if s.runs == 0 {
+			s.idle.Broadcast()
+		}
+		s.runMu.Unlock()
+	}()
+	fn(c)
+}
+
 // ServeHTTP routes requests to the appropriate handlers
 func (s *ContextRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	s.router.ServeHTTP(w, r)
 }
 
 // Stop closes the done channel of the root Context of the server to signal to
-// any long running handlers to stop their work.
+// any long running handlers to stop their work. Open WebSocket connections
+// are sent a close frame within WebSocketCloseTimeout. Stop blocks until all
+// handlers have returned.
 func (s *ContextRouter) Stop() {
+	s.StopTimeout(WebSocketCloseTimeout)
+}
+
+// StopTimeout is like Stop but gives open WebSocket connections up to timeOut
+// to send their close frame. Server.Stop passes its graceful shutdown period.
+func (s *ContextRouter) StopTimeout(timeOut time.Duration) {
+	atomic.StoreInt64(&s.closeTimeout, int64(timeOut))
 	if s.router != nil {
 		s.cancelfunc()
 	}
+	// wait for the handlers and then for the calls to Run, which handlers
+	// may have made, before replacing the context
 	s.Lock()
+	s.runMu.Lock()
+	for s.runs > 0 {
+		s.idle.Wait()
+	}
 	s.context, s.cancelfunc = context.WithCancel(context.Background())
+	s.runMu.Unlock()
 	s.Unlock()
 }
@@ -0,0 +1,101 @@
+package contextrouter
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/context"
+	"golang.org/x/net/websocket"
+)
+
+// WebSocketCloseTimeout bounds the time taken to send the close frame by
+// Conn.Close and by ContextRouter.Stop. ContextRouter.StopTimeout, which
+// Server.Stop calls with its graceful shutdown period, sets its own bound.
+const WebSocketCloseTimeout = time.Millisecond * 100
+
+// WebSocketHandler handles a WebSocket connection. The root Context is passed
+// as c and the connection is closed when the handler returns. Handlers
+// typically loop on Conn.Receive() which returns an error once the
+// connection is closed either by the client or by the router on Stop().
+type WebSocketHandler func(c context.Context, conn *Conn)
+
+// Conn is a message oriented WebSocket connection. Sends and receives are
+// each safe to call from one goroutine at a time.
+type Conn struct {
+	ws        *websocket.Conn
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// Request returns the HTTP request that opened the connection.
+func (c *Conn) Request() *http.Request {
+	return c.ws.Request()
+}
+
+// Receive returns the next text or binary message.
+func (c *Conn) Receive() ([]byte, error) {
+	var msg []byte
+	err := websocket.Message.Receive(c.ws, &msg)
+	return msg, err
+}
+
+// ReceiveJSON decodes the next message as JSON into v.
+func (c *Conn) ReceiveJSON(v interface{}) error {
+	return websocket.JSON.Receive(c.ws, v)
+}
+
+// Send sends msg as a text message.
+func (c *Conn) Send(msg string) error {
+	return websocket.Message.Send(c.ws, msg)
+}
+
+// SendBinary sends msg as a binary message.
+func (c *Conn) SendBinary(msg []byte) error {
+	return websocket.Message.Send(c.ws, msg)
+}
+
+// SendJSON sends v encoded as JSON as a text message.
+func (c *Conn) SendJSON(v interface{}) error {
+	return websocket.JSON.Send(c.ws, v)
+}
+
+// Close sends a close frame and closes the connection. It waits no longer
+// than WebSocketCloseTimeout for the close frame to be written.
+func (c *Conn) Close() error {
+	return c.closeTimeout(WebSocketCloseTimeout)
+}
+
+func (c *Conn) closeTimeout(timeOut time.Duration) error {
+	c.closeOnce.Do(func() {
+		c.ws.SetWriteDeadline(time.Now().Add(timeOut))
+		c.closeErr = c.ws.Close()
+	})
+	return c.closeErr
+}
+
+// HandleWebSocket registers a WebSocketHandler for GET requests upgrading to
+// a WebSocket connection on the required route. When the router is stopped,
+// the connection is closed with a close frame so that clients see an orderly
+// shutdown rather than a dropped connection.
+func (s *ContextRouter) HandleWebSocket(path string, handler WebSocketHandler) {
+	s.HandleFunc(GET, path, func(c context.Context, w http.ResponseWriter, r *http.Request) {
+		websocket.Handler(func(ws *websocket.Conn) {
+			conn := &Conn{ws: ws}
+			defer conn.Close()
+
+			done := make(chan struct{})
+			defer close(done)
+			go func() {
+				select {
+				case <-c.Done():
+					conn.closeTimeout(time.Duration(atomic.LoadInt64(&s.closeTimeout)))
+				case <-done:
+				}
+			}()
+
+			handler(c, conn)
+		}).ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,16 @@
+//go:build dev
+// +build dev
+
+package todoapp
+
+import (
+	"path/filepath"
+	"runtime"
+)
+
+// devDir points at the frontend sources next to this file so that building
+// with -tags dev serves them from disk with live reload on the desktop.
+var devDir = func() string {
+	_, fpath, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(fpath), "data", "res")
+}()
@@ -0,0 +1,7 @@
+//go:build !dev
+// +build !dev
+
+package todoapp
+
+// devDir is empty in release builds to serve the assets embedded in data.
+var devDir = ""
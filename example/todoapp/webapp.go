@@ -1,16 +1,15 @@
 package todoapp
 
 import (
-	"bytes"
-	"fmt"
-	"image"
-	"image/jpeg"
 	"log"
 	"net/http"
+	"path/filepath"
 	"time"
 
+	"github.com/srinathh/mobilehtml5app/assets"
 	"github.com/srinathh/mobilehtml5app/contextrouter"
 	"github.com/srinathh/mobilehtml5app/example/todoapp/data"
+	"github.com/srinathh/mobilehtml5app/images"
 	"github.com/srinathh/mobilehtml5app/server"
 	"golang.org/x/net/context"
 )
@@ -19,7 +18,8 @@ import (
 type App struct {
 	srv *server.Server
 	bk  backend
-	bg  image.Image
+	res *assets.Fingerprinter
+	img *images.Server
 }
 
 // NewApp returns an App
@@ -29,7 +29,20 @@ func NewApp(pdir string) (*App, error) {
 	if err != nil {
 		return nil, err
 	}
-	bg, err := loadBG()
+
+	var res http.FileSystem = assets.Bindata{
+		Asset:     data.Asset,
+		AssetInfo: data.AssetInfo,
+		AssetDir:  data.AssetDir,
+	}
+	if devDir != "" {
+		res = http.Dir(devDir)
+	}
+	fp := assets.Mount(srv.Router, "/res", res, devDir)
+	img, err := images.New(res, images.Options{
+		CacheDir: filepath.Join(pdir, "imgcache"),
+		Hashes:   fp.Hashes(),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -37,15 +50,15 @@ func NewApp(pdir string) (*App, error) {
 	app := &App{
 		srv: srv,
 		bk:  bk,
-		bg:  bg,
+		res: fp,
+		img: img,
 	}
 
-	srv.Router.HandleFunc(contextrouter.GET, "/", logger(serveIndex))
+	srv.Router.HandleFunc(contextrouter.GET, "/", logger(app.serveIndex))
 	srv.Router.HandleFunc(contextrouter.GET, "/items", logger(app.fetchAll))
 	srv.Router.HandleFunc(contextrouter.POST, "/items/new", logger(app.createItem))
 	srv.Router.HandleFunc(contextrouter.GET, "/items/:itemid", logger(app.deleteItem))
-	srv.Router.HandleFunc(contextrouter.GET, "/res/*respath", logger(serveRes))
-	srv.Router.HandleFunc(contextrouter.GET, "/bg/:width/:height", logger(app.serveBg))
+	srv.Router.HandleFunc(contextrouter.GET, "/bg/:width/:height", logger(img.Image("img/bg.jpg", images.Fill).ServeHTTP))
 
 	return app, nil
 }
@@ -74,11 +87,3 @@ type backend interface {
 	delete(id string) error
 	stop()
 }
-
-func loadBG() (image.Image, error) {
-	byt, err := data.Asset("img/bg.jpg")
-	if err != nil {
-		return nil, fmt.Errorf("error loading background image: %s", err)
-	}
-	return jpeg.Decode(bytes.NewReader(byt))
-}
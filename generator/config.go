@@ -0,0 +1,95 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ConfigFile is the name of the file in the project folder storing the Config
+// of the project
+const ConfigFile = "mobilehtml5app.json"
+
+// LoadConfig returns the Config stored in ConfigFile in fs or a zero Config if
+// there is no such file.
+func LoadConfig(fs FS) (Config, error) {
+	var cfg Config
+	b, err := fs.ReadFile(ConfigFile)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cfg); err != nil {
+		return cfg, fmt.Errorf("could not decode %s: %s", ConfigFile, err)
+	}
+	return cfg, nil
+}
+
+// SaveConfig stores cfg in ConfigFile in fs.
+func SaveConfig(fs FS, cfg Config) error {
+	b, err := marshalConfig(cfg)
+	if err != nil {
+		return err
+	}
+	return fs.WriteFile(ConfigFile, b)
+}
+
+// marshalConfig returns cfg as indented JSON
+func marshalConfig(cfg Config) ([]byte, error) {
+	b, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("could not encode %s: %s", ConfigFile, err)
+	}
+	return append(b, '\n'), nil
+}
+
+// Parts of the version incremented by BumpVersion
+const (
+	Major = "major"
+	Minor = "minor"
+	Patch = "patch"
+)
+
+// BumpVersion returns cfg with the part of its Version given as Major, Minor
+// or Patch incremented and VersionCode incremented. Versions must be composed
+// of up to three numbers separated by dots and are returned with three
+// numbers, so that bumping the patch of 1.0 gives 1.0.1.
+func BumpVersion(cfg Config, part string) (Config, error) {
+	cfg = cfg.WithDefaults()
+	parts := strings.Split(cfg.Version, ".")
+	if len(parts) > 3 {
+		return cfg, fmt.Errorf("version must be composed of up to three numbers to be bumped. Got %s", cfg.Version)
+	}
+	nums := make([]int, 3)
+	for j, s := range parts {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return cfg, fmt.Errorf("version must be composed of up to three numbers to be bumped. Got %s", cfg.Version)
+		}
+		nums[j] = n
+	}
+
+	switch part {
+	case Major:
+		nums = []int{nums[0] + 1, 0, 0}
+	case Minor:
+		nums = []int{nums[0], nums[1] + 1, 0}
+	case Patch:
+		nums[2]++
+	default:
+		return cfg, fmt.Errorf("part of the version must be major, minor or patch. Got %s", part)
+	}
+	if cfg.VersionCode >= maxVersionCode {
+		return cfg, fmt.Errorf("version code %d cannot be incremented", cfg.VersionCode)
+	}
+	cfg.Version = fmt.Sprintf("%d.%d.%d", nums[0], nums[1], nums[2])
+	cfg.VersionCode++
+	return cfg, nil
+}
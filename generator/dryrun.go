@@ -0,0 +1,232 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"sync"
+)
+
+// Actions of a Change
+const (
+	Create = "create"
+	Modify = "modify"
+	Delete = "delete"
+)
+
+// Change is a change DryRun would make to a file
+type Change struct {
+	Path   string
+	Action string
+	// Diff is the unified diff of the file for Modify. Like git, only the
+	// fact that they differ is reported for binary files.
+	Diff []byte
+}
+
+// DryRun is an FS recording the changes Generate or Upgrade would make to the
+// wrapped FS without making them. Reads return the recorded changes.
+type DryRun struct {
+	fs      FS
+	written map[string][]byte
+	removed map[string]bool
+	sync.Mutex
+}
+
+// NewDryRun returns a DryRun recording changes to fs
+func NewDryRun(fs FS) *DryRun {
+	return &DryRun{
+		fs:      fs,
+		written: make(map[string][]byte),
+		removed: make(map[string]bool),
+	}
+}
+
+// ReadFile implements FS
+func (d *DryRun) ReadFile(name string) ([]byte, error) {
+	d.Lock()
+	defer d.Unlock()
+	name = path.Clean(name)
+	if d.removed[name] {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	if b, ok := d.written[name]; ok {
+		return append([]byte(nil), b...), nil
+	}
+	return d.fs.ReadFile(name)
+}
+
+// WriteFile implements FS
+func (d *DryRun) WriteFile(name string, data []byte) error {
+	d.Lock()
+	defer d.Unlock()
+	name = path.Clean(name)
+	delete(d.removed, name)
+	d.written[name] = append([]byte(nil), data...)
+	return nil
+}
+
+// MkdirAll implements FS. Folders are not reported as changes.
+func (d *DryRun) MkdirAll(name string) error {
+	return nil
+}
+
+// Remove implements FS
+func (d *DryRun) Remove(name string) error {
+	if _, err := d.ReadFile(name); err != nil {
+		return err
+	}
+	d.Lock()
+	defer d.Unlock()
+	name = path.Clean(name)
+	delete(d.written, name)
+	d.removed[name] = true
+	return nil
+}
+
+// Changes returns the changes to the wrapped FS sorted by path. Files written
+// with their current contents are not reported.
+func (d *DryRun) Changes() ([]Change, error) {
+	d.Lock()
+	defer d.Unlock()
+	var changes []Change
+	for name, b := range d.written {
+		orig, err := d.fs.ReadFile(name)
+		switch {
+		case os.IsNotExist(err):
+			changes = append(changes, Change{Path: name, Action: Create})
+		case err != nil:
+			return nil, err
+		case bytes.Equal(orig, b):
+		case isBinary(orig) || isBinary(b):
+			diff := fmt.Sprintf("Binary files a/%s and b/%s differ\n", name, name)
+			changes = append(changes, Change{Path: name, Action: Modify, Diff: []byte(diff)})
+		default:
+			changes = append(changes, Change{Path: name, Action: Modify, Diff: unifiedDiff(name, orig, b)})
+		}
+	}
+	for name := range d.removed {
+		if _, err := d.fs.ReadFile(name); err == nil {
+			changes = append(changes, Change{Path: name, Action: Delete})
+		}
+	}
+	sort.Sort(changeSorter(changes))
+	return changes, nil
+}
+
+type changeSorter []Change
+
+func (s changeSorter) Len() int           { return len(s) }
+func (s changeSorter) Less(i, j int) bool { return s[i].Path < s[j].Path }
+func (s changeSorter) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// diffContext is the number of unchanged lines around changes in a hunk
+const diffContext = 3
+
+// diffLine is a line of a unified diff with its kind ' ', '-' or '+'
+type diffLine struct {
+	kind byte
+	text string
+}
+
+// unifiedDiff returns the unified diff from a to b of the file name
+func unifiedDiff(name string, a, b []byte) []byte {
+	al, bl := splitLines(a), splitLines(b)
+	match := matchLines(al, bl)
+
+	var lines []diffLine
+	i, j := 0, 0
+	for i < len(al) || j < len(bl) {
+		switch {
+		case i < len(al) && match[i] == j:
+			lines = append(lines, diffLine{' ', al[i]})
+			i, j = i+1, j+1
+		case i < len(al) && match[i] < 0:
+			lines = append(lines, diffLine{'-', al[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', bl[j]})
+			j++
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "--- a/%s\n+++ b/%s\n", name, name)
+	// aline and bline are the line numbers before lines[k] in a and b
+	aline, bline := 0, 0
+	for k := 0; k < len(lines); {
+		if lines[k].kind == ' ' {
+			aline, bline = aline+1, bline+1
+			k++
+			continue
+		}
+
+		// extend the hunk until diffContext unchanged lines follow the last
+		// change, merging changes separated by up to twice as many
+		start := k - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end := k
+		for end < len(lines) {
+			if lines[end].kind != ' ' {
+				end++
+				continue
+			}
+			next := end
+			for next < len(lines) && lines[next].kind == ' ' && next-end < 2*diffContext+1 {
+				next++
+			}
+			if next == len(lines) || next-end > 2*diffContext {
+				break
+			}
+			end = next
+		}
+		stop := end + diffContext
+		if stop > len(lines) {
+			stop = len(lines)
+		}
+
+		astart, bstart := aline-(k-start), bline-(k-start)
+		acount, bcount := 0, 0
+		for _, l := range lines[start:stop] {
+			if l.kind != '+' {
+				acount++
+			}
+			if l.kind != '-' {
+				bcount++
+			}
+		}
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(astart, acount), hunkRange(bstart, bcount))
+		for _, l := range lines[start:stop] {
+			out.WriteByte(l.kind)
+			out.WriteString(l.text)
+			if len(l.text) == 0 || l.text[len(l.text)-1] != '\n' {
+				out.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		for _, l := range lines[k:stop] {
+			if l.kind != '+' {
+				aline++
+			}
+			if l.kind != '-' {
+				bline++
+			}
+		}
+		k = stop
+	}
+	return out.Bytes()
+}
+
+// hunkRange formats the start and count of lines of a hunk. start is zero
+// based and empty ranges refer to the line before them.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
@@ -0,0 +1,136 @@
+package generator
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// FS is the filesystem a project is generated into. Names are slash separated
+// paths relative to the project folder.
+type FS interface {
+	// ReadFile returns the contents of the file name or an error satisfying
+	// os.IsNotExist if there is no such file
+	ReadFile(name string) ([]byte, error)
+	// WriteFile creates or replaces the file name whose folder must exist
+	WriteFile(name string, data []byte) error
+	// MkdirAll creates the folder name along with any parents
+	MkdirAll(name string) error
+	// Remove removes the file name
+	Remove(name string) error
+}
+
+// DirFS is an FS writing to the folder it names
+type DirFS string
+
+func (d DirFS) path(name string) string {
+	return filepath.Join(string(d), filepath.FromSlash(name))
+}
+
+// ReadFile implements FS
+func (d DirFS) ReadFile(name string) ([]byte, error) {
+	return ioutil.ReadFile(d.path(name))
+}
+
+// WriteFile implements FS. Scripts starting with #! are made executable.
+func (d DirFS) WriteFile(name string, data []byte) error {
+	perm := os.FileMode(0644)
+	if bytes.HasPrefix(data, []byte("#!")) {
+		perm = 0755
+	}
+	return ioutil.WriteFile(d.path(name), data, perm)
+}
+
+// MkdirAll implements FS
+func (d DirFS) MkdirAll(name string) error {
+	return os.MkdirAll(d.path(name), 0775)
+}
+
+// Remove implements FS
+func (d DirFS) Remove(name string) error {
+	return os.Remove(d.path(name))
+}
+
+// MemFS is an in-memory FS for tests and for inspecting a project before
+// writing it
+type MemFS struct {
+	Files map[string][]byte
+	Dirs  map[string]bool
+	sync.Mutex
+}
+
+// NewMemFS returns an empty MemFS
+func NewMemFS() *MemFS {
+	return &MemFS{
+		Files: make(map[string][]byte),
+		Dirs:  map[string]bool{".": true},
+	}
+}
+
+// ReadFile implements FS
+func (m *MemFS) ReadFile(name string) ([]byte, error) {
+	m.Lock()
+	defer m.Unlock()
+	b, ok := m.Files[path.Clean(name)]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	return append([]byte(nil), b...), nil
+}
+
+// WriteFile implements FS
+func (m *MemFS) WriteFile(name string, data []byte) error {
+	m.Lock()
+	defer m.Unlock()
+	name = path.Clean(name)
+	if !m.Dirs[path.Dir(name)] {
+		return &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	m.Files[name] = append([]byte(nil), data...)
+	return nil
+}
+
+// MkdirAll implements FS
+func (m *MemFS) MkdirAll(name string) error {
+	m.Lock()
+	defer m.Unlock()
+	for name = path.Clean(name); name != "." && name != "/"; name = path.Dir(name) {
+		if _, ok := m.Files[name]; ok {
+			return &os.PathError{Op: "mkdir", Path: name, Err: os.ErrExist}
+		}
+		m.Dirs[name] = true
+	}
+	return nil
+}
+
+// Remove implements FS
+func (m *MemFS) Remove(name string) error {
+	m.Lock()
+	defer m.Unlock()
+	name = path.Clean(name)
+	if _, ok := m.Files[name]; !ok {
+		return &os.PathError{Op: "remove", Path: name, Err: os.ErrNotExist}
+	}
+	delete(m.Files, name)
+	return nil
+}
+
+// Names returns the names of the files in m with the given prefix in sorted
+// order
+func (m *MemFS) Names(prefix string) []string {
+	m.Lock()
+	defer m.Unlock()
+	var names []string
+	for name := range m.Files {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,64 @@
+//go:build ignore
+// +build ignore
+
+// gen_wrapper generates wrapper.go from a gradle wrapper. Create the wrapper
+// of DefaultGradle with its checksum in an empty folder and pass the folder:
+//
+//	gradle wrapper --gradle-version 8.7 --gradle-distribution-sha256-sum <DefaultGradleSHA256>
+//	go run gen_wrapper.go <folder>
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+const properties = "gradle/wrapper/gradle-wrapper.properties"
+
+var files = []string{"gradlew", "gradlew.bat", "gradle/wrapper/gradle-wrapper.jar"}
+
+// distribution matches the gradle version in the distributionUrl of the
+// wrapper properties
+var distribution = regexp.MustCompile(`distributionUrl=.*/gradle-([0-9.]+)-(bin|all)\.zip`)
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatal("usage: go run gen_wrapper.go <folder of a gradle wrapper>")
+	}
+	src := os.Args[1]
+	props, err := ioutil.ReadFile(filepath.Join(src, filepath.FromSlash(properties)))
+	if err != nil {
+		log.Fatal(err)
+	}
+	m := distribution.FindSubmatch(props)
+	if m == nil {
+		log.Fatalf("no gradle version in %s", properties)
+	}
+
+	buf := bytes.Buffer{}
+	fmt.Fprintf(&buf, "// Code generated by gen_wrapper.go from the gradle %s wrapper. DO NOT EDIT.\n\n", m[1])
+	fmt.Fprint(&buf, "package generator\n\n")
+	fmt.Fprint(&buf, "// gradleWrapper holds the gradle wrapper scripts and jar keyed by their\n// paths relative to AndroidDir\n")
+	fmt.Fprint(&buf, "var gradleWrapper = map[string]string{\n")
+	for _, name := range files {
+		b, err := ioutil.ReadFile(filepath.Join(src, filepath.FromSlash(name)))
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Fprintf(&buf, "%q: %q,\n", name, b)
+	}
+	fmt.Fprint(&buf, "}\n")
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("wrapper.go", b, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
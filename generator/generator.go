@@ -0,0 +1,556 @@
+// Package generator generates the Go backend scaffolding and the Android
+// project of a mobilehtml5app webapp. It is used by the mobilehtml5app
+// command and can be embedded in other tooling.
+//
+// The project is described by a Config and written by Generate through an FS
+// so that it can be generated into a folder with DirFS or into memory with
+// MemFS:
+//
+//	importPath, pkgName, err := generator.DetectPackage(".", "")
+//	...
+//	cfg := generator.Config{Name: "TodoApp", ImportPath: importPath, PackageName: pkgName}
+//	err = generator.Generate(context.Background(), cfg, generator.DirFS("."))
+//
+// Generate writes webapp.go along with a gradle project in the androidapp
+// folder. The whole project is rendered from templates so no Android SDK
+// tools are needed to generate it.
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"net/url"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"golang.org/x/net/context"
+)
+
+// Defaults used by Generate for zero fields of the Config
+const (
+	DefaultAPILevel = 34
+	DefaultGradle   = "8.7"
+	DefaultPlugin   = "8.5.2"
+	DefaultWebView  = "system"
+)
+
+// DefaultGradleSHA256 is the SHA-256 checksum of the DefaultGradle
+// distribution which the gradle wrapper verifies after downloading it
+const DefaultGradleSHA256 = "544c35d6bd849ae8a5ed0bcea39ba677dc40f49df7d1835561582da2009b961d"
+
+// MinKeepAliveAPI is the lowest Android API level supporting the foreground
+// service generated for Config.KeepAlive
+const MinKeepAliveAPI = 29
+
+// The minSdk of the generated project. The foreground service generated for
+// Config.KeepAlive needs notification channels introduced in API 26.
+const (
+	minSDK          = 19
+	minKeepAliveSDK = 26
+)
+
+// AndroidABIs maps the Android ABIs Config.ABIs may select to the gomobile
+// targets building them
+var AndroidABIs = map[string]string{
+	"armeabi-v7a": "android/arm",
+	"arm64-v8a":   "android/arm64",
+	"x86":         "android/386",
+	"x86_64":      "android/amd64",
+}
+
+// NativePkg is bound along with the webapp so that the native portion of the
+// app can implement its interfaces
+const NativePkg = "github.com/srinathh/mobilehtml5app/native"
+
+// AndroidDir is the folder of the Android project within the project folder
+const AndroidDir = "androidapp"
+
+// Defaults of the version of the app
+const (
+	DefaultVersion     = "1.0"
+	DefaultVersionCode = 1
+)
+
+// StorePasswordEnv and KeyPasswordEnv are the environment variables the
+// passwords of Config.Keystore and of the key in it are read from when
+// building the release version of the app. They are not stored in the
+// project so that they are not committed along with it.
+const (
+	StorePasswordEnv = "MOBILEHTML5APP_STORE_PASSWORD"
+	KeyPasswordEnv   = "MOBILEHTML5APP_KEY_PASSWORD"
+)
+
+// maxVersionCode is the largest versionCode Google Play accepts
+const maxVersionCode = 2100000000
+
+// ErrExists is returned by Generate if the project folder already contains
+// an Android project
+var ErrExists = errors.New(AndroidDir + " already exists")
+
+var (
+	validName       = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+	validPermission = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*(\.[a-zA-Z_][a-zA-Z0-9_]*)*$`)
+	validVersion    = regexp.MustCompile(`^[0-9a-zA-Z][0-9a-zA-Z._+-]*$`)
+	validMimeType   = regexp.MustCompile(`^(\*|[a-z0-9.+-]+)/(\*|[a-zA-Z0-9.+-]+)$`)
+	validSHA256     = regexp.MustCompile(`^[0-9a-f]{64}$`)
+)
+
+// Config describes the project to generate. It is stored in ConfigFile in
+// the project folder as JSON with the field names given by the json tags.
+type Config struct {
+	// Name is the Android project name composed of a-z A-Z 0-9 _
+	Name string `json:"name"`
+	// Title is the title of the app and defaults to Name
+	Title string `json:"title,omitempty"`
+	// ImportPath and PackageName are the Go import path and package name of
+	// the webapp as returned by DetectPackage
+	ImportPath  string `json:"module,omitempty"`
+	PackageName string `json:"package,omitempty"`
+	// Targets are the mobile platforms to generate projects for. Only
+	// android is supported for now.
+	Targets []string `json:"targets,omitempty"`
+	// APILevel is the Android API level to compile against and target
+	APILevel int `json:"apiLevel,omitempty"`
+	// Gradle is the gradle version of the gradle wrapper
+	Gradle string `json:"gradle,omitempty"`
+	// GradleSHA256 is the SHA-256 checksum of the gradle distribution the
+	// gradle wrapper verifies. It defaults to DefaultGradleSHA256 for
+	// DefaultGradle and is not verified for other versions if empty.
+	GradleSHA256 string `json:"gradleSha256,omitempty"`
+	// Plugin is the Android gradle plugin version
+	Plugin string `json:"plugin,omitempty"`
+	// WebView must be system to use the Android WebView. The discontinued
+	// CrossWalk XWalkView selected by xwalk is no longer available.
+	WebView string `json:"webview,omitempty"`
+	// KeepAlive generates a foreground Service owning the App so that the
+	// server keeps running while the app is in the background
+	KeepAlive bool `json:"keepAlive,omitempty"`
+	// Permissions are Android permissions requested in addition to those
+	// needed by the generated app. Names without a package such as CAMERA
+	// refer to android.permission.CAMERA.
+	Permissions []string `json:"permissions,omitempty"`
+	// MetaData are meta-data entries of the application in the manifest
+	// such as API keys of services keyed by their names
+	MetaData map[string]string `json:"metaData,omitempty"`
+	// DeepLinks are URL prefixes such as myapp://items or
+	// https://example.com/items opening the app. The links are passed to
+	// App.ResolveLink which returns the in-app URL to load for them.
+	DeepLinks []string `json:"deepLinks,omitempty"`
+	// ShareTypes are MIME types such as text/plain or image/* of content
+	// other apps can share into the app. The content is passed to the
+	// native.ShareReceiver returned by App.Shares.
+	ShareTypes []string `json:"shareTypes,omitempty"`
+	// Icon is the path of a PNG or JPEG image relative to the project folder
+	// the launcher icons are rendered from by Icons instead of using a
+	// placeholder
+	Icon string `json:"icon,omitempty"`
+	// IconBackground is the colour of the background layer of the adaptive
+	// launcher icon as #RRGGBB or #AARRGGBB
+	IconBackground string `json:"iconBackground,omitempty"`
+	// Splash is the background colour of a splash screen showing the icon
+	// while the app starts. There is no splash screen if it is empty.
+	Splash string `json:"splash,omitempty"`
+	// Version is the version of the app shown to users such as 1.2.3 and
+	// VersionCode is the number of the version which must increase with
+	// every release. Both are embedded in the Go package as the constants
+	// Version and VersionCode.
+	Version     string `json:"version,omitempty"`
+	VersionCode int    `json:"versionCode,omitempty"`
+	// Keystore is the path of the keystore relative to the project folder
+	// the release version of the app is signed with using the key KeyAlias.
+	// The release version is not signed if it is empty.
+	Keystore string `json:"keystore,omitempty"`
+	KeyAlias string `json:"keyAlias,omitempty"`
+	// ABIs are the Android ABIs such as arm64-v8a the Go backend is built
+	// for. All of AndroidABIs are built if it is empty.
+	ABIs []string `json:"abis,omitempty"`
+}
+
+// WithDefaults returns cfg with zero fields set to their defaults
+func (cfg Config) WithDefaults() Config {
+	if cfg.Title == "" {
+		cfg.Title = cfg.Name
+	}
+	if len(cfg.Targets) == 0 {
+		cfg.Targets = []string{"android"}
+	}
+	if cfg.APILevel == 0 {
+		cfg.APILevel = DefaultAPILevel
+	}
+	if cfg.Gradle == "" {
+		cfg.Gradle = DefaultGradle
+	}
+	if cfg.Gradle == DefaultGradle && cfg.GradleSHA256 == "" {
+		cfg.GradleSHA256 = DefaultGradleSHA256
+	}
+	if cfg.Plugin == "" {
+		cfg.Plugin = DefaultPlugin
+	}
+	if cfg.WebView == "" {
+		cfg.WebView = DefaultWebView
+	}
+	if cfg.Icon != "" && cfg.IconBackground == "" {
+		cfg.IconBackground = DefaultIconBackground
+	}
+	if cfg.Version == "" {
+		cfg.Version = DefaultVersion
+	}
+	if cfg.VersionCode == 0 {
+		cfg.VersionCode = DefaultVersionCode
+	}
+	return cfg
+}
+
+func (cfg Config) validate() error {
+	if !validName.MatchString(cfg.Name) {
+		return fmt.Errorf("name must be specified and composed of a-z A-Z 0-9 _. Got %q", cfg.Name)
+	}
+	if cfg.ImportPath == "" || cfg.PackageName == "" {
+		return fmt.Errorf("import path and package name of the webapp must be specified")
+	}
+	for _, target := range cfg.Targets {
+		if target != "android" {
+			return fmt.Errorf("target must be android. Got %s", target)
+		}
+	}
+	switch cfg.WebView {
+	case "system":
+	case "xwalk":
+		return fmt.Errorf("webview xwalk is no longer supported since the CrossWalk project is discontinued and its maven repository is gone. Use system")
+	default:
+		return fmt.Errorf("webview must be system. Got %s", cfg.WebView)
+	}
+	if cfg.GradleSHA256 != "" && !validSHA256.MatchString(cfg.GradleSHA256) {
+		return fmt.Errorf("gradle checksum must be 64 hex digits. Got %q", cfg.GradleSHA256)
+	}
+	if cfg.KeepAlive && cfg.APILevel < MinKeepAliveAPI {
+		return fmt.Errorf("keepalive requires API level %d or higher. Got %d", MinKeepAliveAPI, cfg.APILevel)
+	}
+	for _, link := range cfg.DeepLinks {
+		if u, err := url.Parse(link); err != nil || u.Scheme == "" || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+			return fmt.Errorf("deep links must be URLs with a scheme, a host and an optional path. Got %q", link)
+		}
+	}
+	for _, t := range cfg.ShareTypes {
+		if !validMimeType.MatchString(t) {
+			return fmt.Errorf("share types must be MIME types such as text/plain or image/*. Got %q", t)
+		}
+	}
+	for _, perm := range cfg.Permissions {
+		if !validPermission.MatchString(perm) {
+			return fmt.Errorf("invalid permission %q", perm)
+		}
+	}
+	switch strings.ToLower(path.Ext(cfg.Icon)) {
+	case "", ".png", ".jpg", ".jpeg":
+	default:
+		return fmt.Errorf("icon must be a PNG or JPEG image. Got %s", cfg.Icon)
+	}
+	for _, c := range []string{cfg.IconBackground, cfg.Splash} {
+		if c != "" && !validColor.MatchString(c) {
+			return fmt.Errorf("colours must be given as #RRGGBB or #AARRGGBB. Got %s", c)
+		}
+	}
+	if cfg.Splash != "" && cfg.Icon == "" {
+		return fmt.Errorf("splash requires an icon")
+	}
+	if !validVersion.MatchString(cfg.Version) {
+		return fmt.Errorf("version must be composed of a-z A-Z 0-9 . _ + -. Got %q", cfg.Version)
+	}
+	if cfg.VersionCode < 1 || cfg.VersionCode > maxVersionCode {
+		return fmt.Errorf("version code must be between 1 and %d. Got %d", maxVersionCode, cfg.VersionCode)
+	}
+	if (cfg.Keystore == "") != (cfg.KeyAlias == "") {
+		return fmt.Errorf("keystore and key alias must be specified together")
+	}
+	for _, abi := range cfg.ABIs {
+		if _, ok := AndroidABIs[abi]; !ok {
+			return fmt.Errorf("abi must be one of armeabi-v7a, arm64-v8a, x86 or x86_64. Got %s", abi)
+		}
+	}
+	return nil
+}
+
+// Generate writes webapp.go, the Android project described by cfg and cfg
+// itself as ConfigFile to fs. The generated files are recorded in
+// PristineFile for Upgrade. It returns ErrExists without writing anything if
+// fs already contains an Android project.
+func Generate(c context.Context, cfg Config, fs FS) error {
+	files, err := project(cfg, fs)
+	if err != nil {
+		return err
+	}
+	if _, err := fs.ReadFile(AndroidDir + "/build.gradle"); err == nil {
+		return ErrExists
+	}
+
+	var fpaths []string
+	for fpath := range files {
+		fpaths = append(fpaths, fpath)
+	}
+	sort.Strings(fpaths)
+	for _, fpath := range fpaths {
+		select {
+		case <-c.Done():
+			return c.Err()
+		default:
+		}
+		if err := writeFile(fs, fpath, files[fpath]); err != nil {
+			return err
+		}
+	}
+
+	// libs: create the folder for backend.aar which is generated by gradle
+	if err := fs.MkdirAll(AndroidDir + "/libs"); err != nil {
+		return fmt.Errorf("unable to create libs folder: %s", err)
+	}
+	if err := savePristine(fs, files); err != nil {
+		return err
+	}
+	return SaveConfig(fs, cfg.WithDefaults())
+}
+
+// project returns the files rendered for cfg along with the icons rendered
+// from the icon read from fs
+func project(cfg Config, fs FS) (map[string][]byte, error) {
+	files, err := Render(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Icon != "" {
+		b, err := fs.ReadFile(cfg.Icon)
+		if err != nil {
+			return nil, fmt.Errorf("could not read icon: %s", err)
+		}
+		icons, err := Icons(b, cfg)
+		if err != nil {
+			return nil, err
+		}
+		for fpath, b := range icons {
+			files[fpath] = b
+		}
+	}
+	return files, nil
+}
+
+// Render returns the contents of webapp.go and of every file of the Android
+// project described by cfg keyed by their slash separated paths relative to
+// the project folder. The gradle wrapper scripts and jar are bundled and
+// download the gradle version of cfg on first use. The icons rendered from
+// Config.Icon are returned by Icons.
+func Render(cfg Config) (map[string][]byte, error) {
+	cfg = cfg.WithDefaults()
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	p := newParams(cfg)
+
+	srccode, err := format.Source([]byte(fmt.Sprintf("package %s\n%s", cfg.PackageName, webapp)))
+	if err != nil {
+		return nil, fmt.Errorf("gofmt error : %s", err)
+	}
+	files := map[string][]byte{"webapp.go": srccode}
+
+	version, err := execTemplate("version.go", versionDotGoText, p)
+	if err != nil {
+		return nil, err
+	}
+	if files["version.go"], err = format.Source(version); err != nil {
+		return nil, fmt.Errorf("gofmt error : %s", err)
+	}
+
+	javaPath := path.Join(append([]string{AndroidDir, "src", "main", "java"}, strings.Split(p.PkgPath, ".")...)...)
+	templates := map[string]string{
+		AndroidDir + "/.gitignore":                               gitignore,
+		AndroidDir + "/settings.gradle":                          settingsDotGradleText,
+		AndroidDir + "/build.gradle":                             buildDotGradleText,
+		AndroidDir + "/gradle/wrapper/gradle-wrapper.properties": gradleWrapperDotPropertiesText,
+		AndroidDir + "/src/main/res/values/strings.xml":          stringsDotXMLText,
+		path.Join(javaPath, "Main.java"):                         mainDotJavaText,
+		manifestPath:                                             androidManifestDotXMLText,
+		resDir + "/drawable/ic_launcher.xml":                     icLauncherDotXMLText,
+	}
+	if cfg.KeepAlive {
+		templates[path.Join(javaPath, "KeepAliveService.java")] = keepAliveServiceDotJavaText
+	}
+
+	for fpath, text := range templates {
+		b, err := execTemplate(path.Base(fpath), text, p)
+		if err != nil {
+			return nil, err
+		}
+		files[fpath] = b
+	}
+	for name, content := range gradleWrapper {
+		files[AndroidDir+"/"+name] = []byte(content)
+	}
+	if files[manifestPath], err = editManifest(files[manifestPath], cfg); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// manifestPath is the path of the AndroidManifest.xml of the project
+const manifestPath = AndroidDir + "/src/main/AndroidManifest.xml"
+
+// editManifest adds the permissions, meta-data, deep links and share types of
+// cfg to the manifest b
+func editManifest(b []byte, cfg Config) ([]byte, error) {
+	m, err := ParseManifest(b)
+	if err != nil {
+		return nil, err
+	}
+	for _, perm := range cfg.Permissions {
+		m.AddPermission(perm)
+	}
+	names := make([]string, 0, len(cfg.MetaData))
+	for name := range cfg.MetaData {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := m.SetMetaData(name, cfg.MetaData[name]); err != nil {
+			return nil, err
+		}
+	}
+	for _, link := range cfg.DeepLinks {
+		u, err := url.Parse(link)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := m.AddIntentFilter(".Main", IntentFilter{
+			Actions:    []string{"android.intent.action.VIEW"},
+			Categories: []string{"android.intent.category.DEFAULT", "android.intent.category.BROWSABLE"},
+			Data:       []IntentData{{Scheme: u.Scheme, Host: u.Host, PathPrefix: u.Path}},
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(cfg.ShareTypes) > 0 {
+		share := IntentFilter{
+			Actions:    []string{"android.intent.action.SEND", "android.intent.action.SEND_MULTIPLE"},
+			Categories: []string{"android.intent.category.DEFAULT"},
+		}
+		for _, t := range cfg.ShareTypes {
+			share.Data = append(share.Data, IntentData{MimeType: t})
+		}
+		if _, err := m.AddIntentFilter(".Main", share); err != nil {
+			return nil, err
+		}
+	}
+	return m.Bytes(), nil
+}
+
+// params are the parameters for the templates of the project
+type params struct {
+	Name      string
+	Title     string
+	PkgPath   string
+	PkgName   string
+	ClsName   string
+	GoJavaPkg string
+	WebView   string
+	KeepAlive bool
+	DeepLinks bool
+	Share     bool
+	APILevel  int
+	MinSDK    int
+	Gradle    string
+	Plugin    string
+	// GradleSHA256 is the checksum of the gradle distribution if known
+	GradleSHA256 string
+	// Icon is the resource of the launcher icon
+	Icon           string
+	IconBackground string
+	Splash         string
+	Version        string
+	VersionCode    int
+	// Keystore is the path of the keystore relative to the Android project
+	Keystore         string
+	KeyAlias         string
+	StorePasswordEnv string
+	KeyPasswordEnv   string
+}
+
+func newParams(cfg Config) params {
+	p := params{
+		Name:      cfg.Name,
+		Title:     cfg.Title,
+		PkgPath:   JavaPackage(cfg.ImportPath) + "." + AndroidDir,
+		PkgName:   cfg.PackageName,
+		ClsName:   strings.ToUpper(cfg.PackageName[:1]) + cfg.PackageName[1:],
+		GoJavaPkg: gomobileJavaPackage(cfg.PackageName),
+		WebView:   cfg.WebView,
+		KeepAlive: cfg.KeepAlive,
+		DeepLinks: len(cfg.DeepLinks) > 0,
+		Share:     len(cfg.ShareTypes) > 0,
+		APILevel:  cfg.APILevel,
+		MinSDK:    minSDK,
+		Gradle:    cfg.Gradle,
+		Plugin:    cfg.Plugin,
+		Icon:      "@drawable/ic_launcher",
+
+		GradleSHA256:   cfg.GradleSHA256,
+		IconBackground: cfg.IconBackground,
+		Splash:         cfg.Splash,
+		Version:        cfg.Version,
+		VersionCode:    cfg.VersionCode,
+
+		KeyAlias:         cfg.KeyAlias,
+		StorePasswordEnv: StorePasswordEnv,
+		KeyPasswordEnv:   KeyPasswordEnv,
+	}
+	switch {
+	case cfg.Keystore == "":
+	case filepath.IsAbs(cfg.Keystore):
+		p.Keystore = filepath.ToSlash(cfg.Keystore)
+	default:
+		p.Keystore = path.Join("..", filepath.ToSlash(cfg.Keystore))
+	}
+	if cfg.Icon != "" {
+		p.Icon = "@mipmap/ic_launcher"
+	}
+	if cfg.KeepAlive {
+		p.MinSDK = minKeepAliveSDK
+	}
+
+	return p
+}
+
+var templateFuncs = template.FuncMap{
+	"androidString": androidString,
+	"groovyString":  groovyStringReplacer.Replace,
+}
+
+// groovyStringReplacer escapes strings for use in single quoted strings of
+// gradle files
+var groovyStringReplacer = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+var androidStringReplacer = strings.NewReplacer(`\`, `\\`, `'`, `\'`, `"`, `\"`, "&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// androidString escapes s for use as the value of a string resource
+func androidString(s string) string {
+	s = androidStringReplacer.Replace(s)
+	if strings.HasPrefix(s, "@") || strings.HasPrefix(s, "?") {
+		s = `\` + s
+	}
+	return s
+}
+
+func execTemplate(fname, text string, p params) ([]byte, error) {
+	tmpl, err := template.New(fname).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	buf := bytes.Buffer{}
+	if err := tmpl.Execute(&buf, p); err != nil {
+		return nil, fmt.Errorf("error writing %s :%s", fname, err)
+	}
+	return bytes.TrimLeft(buf.Bytes(), "\n"), nil
+}
@@ -0,0 +1,938 @@
+package generator
+
+import (
+	"bytes"
+	"flag"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata/golden")
+
+var testConfig = Config{
+	Name:        "TestApp",
+	Title:       "Test's App",
+	ImportPath:  "example.com/testapp",
+	PackageName: "testapp",
+}
+
+const javaDir = "androidapp/src/main/java/com/example/testapp/androidapp/"
+
+func TestMainDotJava(t *testing.T) {
+	files, _ := Render(testConfig)
+	ret := files[javaDir+"Main.java"]
+	if !strings.Contains(string(ret), "package com.example.testapp") {
+		t.Error("Error in package statement")
+	}
+
+	if !strings.Contains(string(ret), "import testapp.Testapp;") {
+		t.Error("Error in import statement")
+	}
+
+	if !strings.Contains(string(ret), "mSrv = Testapp.newApp()") {
+		t.Error("Error in webapp creation")
+	}
+
+	if !strings.Contains(string(ret), "mSrv.start()") {
+		t.Error("Error in webapp start")
+	}
+
+	if !strings.Contains(string(ret), "mSrv.stop()") {
+		t.Error("Error in webapp stop")
+	}
+
+	for _, want := range []string{"implements native_.WebView", "mSrv.setWebView(mBridge);", "mSrv.setWebView(null);"} {
+		if !strings.Contains(string(ret), want) {
+			t.Errorf("Main.java does not attach the WebView: missing %s", want)
+		}
+	}
+}
+
+func TestKeepAlive(t *testing.T) {
+	cfg := testConfig
+	cfg.KeepAlive = true
+
+	files, err := Render(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ret := files[javaDir+"Main.java"]
+	for _, want := range []string{"bindService(intent, mConnection", "mService.detach();", "stopService(", "mService.setWebView(mBridge);", "mService.setWebView(null);", "requestPermissions(new String[]{POST_NOTIFICATIONS}"} {
+		if !strings.Contains(string(ret), want) {
+			t.Errorf("Main.java does not contain %s", want)
+		}
+	}
+	if strings.Contains(string(ret), "mSrv.stop()") {
+		t.Error("Main.java must not stop the server")
+	}
+
+	manifest := files["androidapp/src/main/AndroidManifest.xml"]
+	if !strings.Contains(string(manifest), `android:name=".KeepAliveService"`) ||
+		!strings.Contains(string(manifest), "android.permission.FOREGROUND_SERVICE") {
+		t.Errorf("manifest does not declare the service:\n%s", manifest)
+	}
+
+	ret = files[javaDir+"KeepAliveService.java"]
+	for _, want := range []string{"package com.example.testapp.androidapp;", "import testapp.App;", "mSrv = Testapp.newApp();", "startForeground("} {
+		if !strings.Contains(string(ret), want) {
+			t.Errorf("KeepAliveService.java does not contain %s", want)
+		}
+	}
+
+	cfg.APILevel = 28
+	if _, err := Render(cfg); err == nil || !strings.Contains(err.Error(), "keepalive requires API level 29") {
+		t.Errorf("want API level error got %v", err)
+	}
+}
+
+func TestDeepLinks(t *testing.T) {
+	cfg := testConfig
+	cfg.DeepLinks = []string{"myapp://items", "https://example.com/items"}
+	files, err := Render(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifest := string(files["androidapp/src/main/AndroidManifest.xml"])
+	for _, want := range []string{
+		`android:launchMode="singleTask"`,
+		`<action android:name="android.intent.action.VIEW" />`,
+		`<category android:name="android.intent.category.BROWSABLE" />`,
+		`<data android:scheme="myapp" android:host="items" />`,
+		`<data android:scheme="https" android:host="example.com" android:pathPrefix="/items" />`,
+	} {
+		if !strings.Contains(manifest, want) {
+			t.Errorf("manifest does not contain %s:\n%s", want, manifest)
+		}
+	}
+	main := string(files[javaDir+"Main.java"])
+	for _, want := range []string{"mLink = deepLink(getIntent());", "protected void onNewIntent(Intent intent)", "mSrv.resolveLink(mLink)"} {
+		if !strings.Contains(main, want) {
+			t.Errorf("Main.java does not contain %s", want)
+		}
+	}
+
+	cfg.DeepLinks = []string{"/items"}
+	if _, err := Render(cfg); err == nil {
+		t.Error("want error for deep link without scheme and host")
+	}
+}
+
+func TestShareTypes(t *testing.T) {
+	cfg := testConfig
+	cfg.ShareTypes = []string{"text/plain", "image/*"}
+	files, err := Render(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifest := string(files["androidapp/src/main/AndroidManifest.xml"])
+	for _, want := range []string{
+		`<action android:name="android.intent.action.SEND" />`,
+		`<action android:name="android.intent.action.SEND_MULTIPLE" />`,
+		`<data android:mimeType="text/plain" />`,
+		`<data android:mimeType="image/*" />`,
+	} {
+		if !strings.Contains(manifest, want) {
+			t.Errorf("manifest does not contain %s:\n%s", want, manifest)
+		}
+	}
+	main := string(files[javaDir+"Main.java"])
+	for _, want := range []string{"mSrv.receiveShares(", "receiveShare();", "shares.addFD(id, pfd.detachFd(), displayName(uri)", "shares.commit(id);"} {
+		if !strings.Contains(main, want) {
+			t.Errorf("Main.java does not contain %s", want)
+		}
+	}
+
+	cfg.KeepAlive = true
+	files, err = Render(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if main := string(files[javaDir+"Main.java"]); !strings.Contains(main, "mService.shares()") {
+		t.Error("Main.java does not get the ShareReceiver from the service")
+	}
+	service := string(files[javaDir+"KeepAliveService.java"])
+	for _, want := range []string{"mSrv.receiveShares(", "public native_.ShareReceiver shares()"} {
+		if !strings.Contains(service, want) {
+			t.Errorf("KeepAliveService.java does not contain %s", want)
+		}
+	}
+
+	cfg.ShareTypes = []string{"text"}
+	if _, err := Render(cfg); err == nil {
+		t.Error("want error for invalid share type")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	fs := NewMemFS()
+	if err := Generate(context.Background(), testConfig, fs); err != nil {
+		t.Fatal(err)
+	}
+	if !fs.Dirs["androidapp/libs"] {
+		t.Error("libs folder not created")
+	}
+	if names := fs.Names("androidapp/src/main/java/"); len(names) != 1 || names[0] != javaDir+"Main.java" {
+		t.Errorf("unexpected java files %v", names)
+	}
+	if err := Generate(context.Background(), testConfig, fs); err != ErrExists {
+		t.Errorf("want ErrExists generating twice got %v", err)
+	}
+
+	c, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := Generate(c, testConfig, NewMemFS()); err != context.Canceled {
+		t.Errorf("want context.Canceled got %v", err)
+	}
+
+	cfg := testConfig
+	cfg.Name = "Test App"
+	if err := Generate(context.Background(), cfg, NewMemFS()); err == nil {
+		t.Error("want error for invalid name")
+	}
+}
+
+func TestConfig(t *testing.T) {
+	fs := NewMemFS()
+	if cfg, err := LoadConfig(fs); err != nil || cfg.Name != "" {
+		t.Errorf("want zero config without %s got %+v %v", ConfigFile, cfg, err)
+	}
+
+	cfg := testConfig
+	cfg.Permissions = []string{"CAMERA"}
+	if err := Generate(context.Background(), cfg, fs); err != nil {
+		t.Fatal(err)
+	}
+
+	saved, err := LoadConfig(fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := cfg.WithDefaults(); !reflect.DeepEqual(saved, want) {
+		t.Errorf("want saved config %+v got %+v", want, saved)
+	}
+
+	fs.WriteFile(ConfigFile, []byte(`{"name": "TestApp", "browser": "xwalk"}`))
+	if _, err := LoadConfig(fs); err == nil || !strings.Contains(err.Error(), "unknown field") {
+		t.Errorf("want error for unknown field got %v", err)
+	}
+
+	cfg.Permissions = []string{"android.permission.CAMERA\" />"}
+	if _, err := Render(cfg); err == nil {
+		t.Error("want error for invalid permission")
+	}
+	cfg.Permissions = nil
+	cfg.Keystore = "release.jks"
+	if _, err := Render(cfg); err == nil {
+		t.Error("want error for keystore without key alias")
+	}
+	cfg.Keystore, cfg.Version = "", `1.0"`
+	if _, err := Render(cfg); err == nil {
+		t.Error("want error for invalid version")
+	}
+	cfg.Version, cfg.WebView = "", "xwalk"
+	if _, err := Render(cfg); err == nil || !strings.Contains(err.Error(), "no longer supported") {
+		t.Errorf("want error for xwalk got %v", err)
+	}
+	cfg.WebView, cfg.GradleSHA256 = "", "sha256:1234"
+	if _, err := Render(cfg); err == nil {
+		t.Error("want error for invalid gradle checksum")
+	}
+
+	// the checksum is only known for the default gradle version
+	cfg.Gradle, cfg.GradleSHA256 = "8.8", ""
+	files, err := Render(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if props := string(files[AndroidDir+"/gradle/wrapper/gradle-wrapper.properties"]); strings.Contains(props, "distributionSha256Sum") {
+		t.Errorf("want no checksum for gradle 8.8 got %s", props)
+	}
+}
+
+func TestBumpVersion(t *testing.T) {
+	for _, test := range []struct {
+		version, part, want string
+	}{
+		{"", Patch, "1.0.1"},
+		{"1.0", Minor, "1.1.0"},
+		{"1.2.3", Major, "2.0.0"},
+		{"1.2.3", Patch, "1.2.4"},
+	} {
+		cfg := testConfig
+		cfg.Version, cfg.VersionCode = test.version, 4
+		got, err := BumpVersion(cfg, test.part)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Version != test.want || got.VersionCode != 5 {
+			t.Errorf("want %s bumped to %s (5) got %s (%d)", test.version, test.want, got.Version, got.VersionCode)
+		}
+	}
+
+	cfg := testConfig
+	cfg.Version = "1.0-beta"
+	if _, err := BumpVersion(cfg, Patch); err == nil {
+		t.Error("want error for version that is not numeric")
+	}
+	if _, err := BumpVersion(testConfig, "build"); err == nil {
+		t.Error("want error for invalid part")
+	}
+}
+
+func TestIcons(t *testing.T) {
+	fs := NewMemFS()
+	var logo bytes.Buffer
+	png.Encode(&logo, image.NewNRGBA(image.Rect(0, 0, 30, 20)))
+	fs.WriteFile("logo.png", logo.Bytes())
+
+	cfg := testConfig
+	cfg.Icon, cfg.Splash = "logo.png", "#123456"
+	if err := Generate(context.Background(), cfg, fs); err != nil {
+		t.Fatal(err)
+	}
+	for fpath, size := range map[string]int{
+		"mipmap-mdpi/ic_launcher.png":               48,
+		"mipmap-xxxhdpi/ic_launcher.png":            192,
+		"mipmap-hdpi/ic_launcher_foreground.png":    162,
+		"mipmap-xxxhdpi/ic_launcher_foreground.png": 432,
+	} {
+		b, err := fs.ReadFile("androidapp/src/main/res/" + fpath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		img, err := png.Decode(bytes.NewReader(b))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := img.Bounds(); got.Dx() != size || got.Dy() != size {
+			t.Errorf("want %s of %dx%[2]d got %v", fpath, size, got)
+		}
+	}
+
+	for fpath, want := range map[string]string{
+		"AndroidManifest.xml":                   `android:theme="@style/SplashTheme"`,
+		"res/mipmap-anydpi-v26/ic_launcher.xml": `@mipmap/ic_launcher_foreground`,
+		"res/values/colors.xml":                 `<color name="splash_background">#123456</color>`,
+		"res/drawable/splash.xml":               `@color/splash_background`,
+		"res/values/styles.xml":                 `@drawable/splash`,
+	} {
+		b, _ := fs.ReadFile("androidapp/src/main/" + fpath)
+		if !bytes.Contains(b, []byte(want)) {
+			t.Errorf("want %s in %s got\n%s", want, fpath, b)
+		}
+	}
+	if b, _ := fs.ReadFile("androidapp/src/main/AndroidManifest.xml"); !bytes.Contains(b, []byte(`android:icon="@mipmap/ic_launcher"`)) {
+		t.Errorf("want mipmap launcher icon got\n%s", b)
+	}
+
+	cfg.Splash = "blue"
+	if _, err := Icons(logo.Bytes(), cfg); err == nil {
+		t.Error("want error for invalid colour")
+	}
+	cfg.Icon, cfg.Splash = "", "#123456"
+	if _, err := Render(cfg); err == nil {
+		t.Error("want error for splash without icon")
+	}
+	if _, err := Icons([]byte("png"), testConfig); err == nil {
+		t.Error("want error for invalid image")
+	}
+}
+
+func TestMerge3(t *testing.T) {
+	base := "a\nb\nc\nd\ne\n"
+	tests := []struct {
+		mine, theirs, want string
+		conflict           bool
+	}{
+		{"a\nB\nc\nd\ne\n", "a\nb\nc\nD\ne\n", "a\nB\nc\nD\ne\n", false},
+		{"x\na\nb\nc\nd\ne\n", "a\nb\nc\nd\ne\ny\n", "x\na\nb\nc\nd\ne\ny\n", false},
+		{"a\nc\nd\ne\n", "a\nb\nc\nd\n", "a\nc\nd\n", false},
+		{"a\nB\nc\nd\ne\n", "a\nB\nc\nd\ne\n", "a\nB\nc\nd\ne\n", false},
+		{"a\nB\nc\nd\ne", "a\nBB\nc\nd\ne\n", "a\n<<<<<<< yours\nB\n||||||| generated\nb\n=======\nBB\n>>>>>>> upgrade\nc\nd\ne", true},
+		{"a\nb\nc\nd\ne\n", "", "", false},
+	}
+	for _, test := range tests {
+		got, conflict := merge3([]byte(base), []byte(test.mine), []byte(test.theirs))
+		if string(got) != test.want || conflict != test.conflict {
+			t.Errorf("merge3(%q, %q): want %q %v got %q %v", test.mine, test.theirs, test.want, test.conflict, got, conflict)
+		}
+	}
+}
+
+func TestUpgrade(t *testing.T) {
+	fs := NewMemFS()
+	if _, err := Upgrade(context.Background(), testConfig, fs); err != ErrNotGenerated {
+		t.Errorf("want ErrNotGenerated got %v", err)
+	}
+	if err := Generate(context.Background(), testConfig, fs); err != nil {
+		t.Fatal(err)
+	}
+
+	// local changes
+	const gradle = "androidapp/build.gradle"
+	const strs = "androidapp/src/main/res/values/strings.xml"
+	b, _ := fs.ReadFile(gradle)
+	fs.WriteFile(gradle, append(b, "// mine\n"...))
+	b, _ = fs.ReadFile(strs)
+	fs.WriteFile(strs, bytes.Replace(b, []byte(`Test\'s App`), []byte("Mine"), 1))
+	fs.Remove("androidapp/.gitignore")
+
+	cfg := testConfig
+	cfg.Title = "New Title"
+	cfg.KeepAlive = true
+	res, err := Upgrade(context.Background(), cfg, fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{javaDir + "KeepAliveService.java"}; !reflect.DeepEqual(res.Added, want) {
+		t.Errorf("want added %v got %v", want, res.Added)
+	}
+	if want := []string{gradle, "androidapp/src/main/AndroidManifest.xml", javaDir + "Main.java"}; !reflect.DeepEqual(res.Updated, want) {
+		t.Errorf("want updated %v got %v", want, res.Updated)
+	}
+	if len(res.Conflicts) != 1 || res.Conflicts[0].Path != strs || !bytes.Contains(res.Conflicts[0].Merged, []byte("=======\n    <string name=\"app_name\">New Title</string>\n")) {
+		t.Errorf("want conflict in %s got %+v", strs, res.Conflicts)
+	}
+	if b, _ := fs.ReadFile(gradle); !bytes.Contains(b, []byte("minSdk 26")) || !bytes.HasSuffix(b, []byte("// mine\n")) {
+		t.Errorf("want merged build.gradle got\n%s", b)
+	}
+	if b, _ := fs.ReadFile(strs); !bytes.Contains(b, []byte("Mine")) {
+		t.Errorf("want conflicting file untouched got\n%s", b)
+	}
+	if b, _ := fs.ReadFile(strs + MergeSuffix); len(res.Conflicts) == 1 && !bytes.Equal(b, res.Conflicts[0].Merged) {
+		t.Errorf("want merged text written to %s got\n%s", strs+MergeSuffix, b)
+	}
+	if _, err := fs.ReadFile("androidapp/.gitignore"); !os.IsNotExist(err) {
+		t.Errorf("want removed file to stay removed got %v", err)
+	}
+	if saved, _ := LoadConfig(fs); saved.Title != "New Title" {
+		t.Errorf("want config saved got %+v", saved)
+	}
+
+	// the conflict is merged against the old base until it is resolved
+	res, err = Upgrade(context.Background(), cfg, fs)
+	if err != nil || len(res.Conflicts) != 1 || res.Conflicts[0].Path != strs {
+		t.Errorf("want conflict in %s again got %+v %v", strs, res, err)
+	}
+	b, _ = fs.ReadFile(strs)
+	fs.WriteFile(strs, bytes.Replace(b, []byte("Mine"), []byte("New Title"), 1))
+
+	// nothing left to do after resolving the conflict
+	res, err = Upgrade(context.Background(), cfg, fs)
+	if err != nil || len(res.Added)+len(res.Updated)+len(res.Removed)+len(res.Conflicts) != 0 {
+		t.Errorf("want no changes got %+v %v", res, err)
+	}
+	if _, err := fs.ReadFile(strs + MergeSuffix); !os.IsNotExist(err) {
+		t.Errorf("want stale merged text removed got %v", err)
+	}
+
+	res, err = Upgrade(context.Background(), testConfig, fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{javaDir + "KeepAliveService.java"}; !reflect.DeepEqual(res.Removed, want) {
+		t.Errorf("want removed %v got %v", want, res.Removed)
+	}
+}
+
+func TestUpgradeMerge(t *testing.T) {
+	fs := NewMemFS()
+	if err := Generate(context.Background(), testConfig, fs); err != nil {
+		t.Fatal(err)
+	}
+
+	// projects generated before files were recorded merge against the lines
+	// common to the local and the upgraded file
+	const manifest = "androidapp/src/main/AndroidManifest.xml"
+	b, _ := fs.ReadFile(manifest)
+	fs.WriteFile(manifest, append(b, "<!-- mine -->\n"...))
+	fs.Remove(PristineFile)
+	cfg := testConfig
+	cfg.DeepLinks = []string{"myapp://items"}
+	res, err := Upgrade(context.Background(), cfg, fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Conflicts) != 0 {
+		t.Errorf("want no conflicts got %+v", res.Conflicts)
+	}
+	if b, _ := fs.ReadFile(manifest); !bytes.Contains(b, []byte(`android:scheme="myapp"`)) || !bytes.HasSuffix(b, []byte("<!-- mine -->\n")) {
+		t.Errorf("want merged manifest got\n%s", b)
+	}
+
+	// binaries are replaced only if unchanged locally and never merged
+	const jar = AndroidDir + "/gradle/wrapper/gradle-wrapper.jar"
+	old := []byte("PK\x00old")
+	pristine, _ := loadPristine(fs)
+	pristine[jar] = old
+	savePristine(fs, pristine)
+	fs.WriteFile(jar, old)
+	res, err = Upgrade(context.Background(), cfg, fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := fs.ReadFile(jar); !reflect.DeepEqual(res.Updated, []string{jar}) || !bytes.Equal(b, []byte(gradleWrapper["gradle/wrapper/gradle-wrapper.jar"])) {
+		t.Errorf("want %s updated got %+v", jar, res)
+	}
+
+	pristine, _ = loadPristine(fs)
+	pristine[jar] = old
+	savePristine(fs, pristine)
+	fs.WriteFile(jar, []byte("PK\x00mine"))
+	res, err = Upgrade(context.Background(), cfg, fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Conflicts) != 1 || res.Conflicts[0].Path != jar || res.Conflicts[0].Merged != nil {
+		t.Errorf("want binary conflict in %s got %+v", jar, res.Conflicts)
+	}
+	if _, err := fs.ReadFile(jar + MergeSuffix); !os.IsNotExist(err) {
+		t.Errorf("want no merged binary got %v", err)
+	}
+	if pristine, _ := loadPristine(fs); !bytes.Equal(pristine[jar], old) {
+		t.Error("want the base of the conflicting binary kept")
+	}
+
+	// files removed locally but changed by the upgrade stay removed and
+	// keep conflicting against their old base
+	fs.Remove(manifest)
+	cfg.DeepLinks = []string{"https://example.com/items"}
+	for i := 0; i < 2; i++ {
+		res, err = Upgrade(context.Background(), cfg, fs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Conflicts) != 2 || res.Conflicts[1].Path != manifest {
+			t.Errorf("want conflict in removed %s got %+v", manifest, res.Conflicts)
+		}
+		if _, err := fs.ReadFile(manifest); !os.IsNotExist(err) {
+			t.Errorf("want removed file to stay removed got %v", err)
+		}
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	a := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n17\n18\n19\n20"
+	b := "1\n2\nthree\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n17\n18\n19\n20\n21\n"
+	want := `--- a/nums
++++ b/nums
+@@ -1,6 +1,6 @@
+ 1
+ 2
+-3
++three
+ 4
+ 5
+ 6
+@@ -17,4 +17,5 @@
+ 17
+ 18
+ 19
+-20
+\ No newline at end of file
++20
++21
+`
+	if got := string(unifiedDiff("nums", []byte(a), []byte(b))); got != want {
+		t.Errorf("want diff\n%s\ngot\n%s", want, got)
+	}
+
+	want = "--- a/nums\n+++ b/nums\n@@ -1,3 +1,2 @@\n 1\n-2\n 3\n"
+	if got := string(unifiedDiff("nums", []byte("1\n2\n3\n"), []byte("1\n3\n"))); got != want {
+		t.Errorf("want diff\n%s\ngot\n%s", want, got)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	fs := NewMemFS()
+	dry := NewDryRun(fs)
+	if err := Generate(context.Background(), testConfig, dry); err != nil {
+		t.Fatal(err)
+	}
+	changes, err := dry.Changes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 15 || changes[0].Action != Create || len(fs.Files) != 0 {
+		t.Fatalf("want 15 files to be created and none written got %+v %v", changes, fs.Names(""))
+	}
+
+	if err := Generate(context.Background(), testConfig, fs); err != nil {
+		t.Fatal(err)
+	}
+	before := fs.Names("")
+	cfg := testConfig
+	cfg.Title = "New Title"
+	dry = NewDryRun(fs)
+	if _, err := Upgrade(context.Background(), cfg, dry); err != nil {
+		t.Fatal(err)
+	}
+	changes, err = dry.Changes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, c := range changes {
+		got = append(got, c.Action+" "+c.Path)
+	}
+	if want := []string{"modify .mobilehtml5app.pristine.json", "modify androidapp/src/main/res/values/strings.xml", "modify mobilehtml5app.json"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want changes %v got %v", want, got)
+	}
+	if !bytes.Contains(changes[1].Diff, []byte("-    <string name=\"app_name\">Test\\'s App</string>\n+    <string name=\"app_name\">New Title</string>\n")) {
+		t.Errorf("unexpected diff\n%s", changes[1].Diff)
+	}
+	if b, _ := fs.ReadFile("androidapp/src/main/res/values/strings.xml"); bytes.Contains(b, []byte("New Title")) || !reflect.DeepEqual(fs.Names(""), before) {
+		t.Error("dry run changed files")
+	}
+
+	dry.Remove("webapp.go")
+	if changes, _ := dry.Changes(); changes[len(changes)-1].Action != Delete {
+		t.Errorf("want webapp.go deleted got %+v", changes)
+	}
+
+	const jar = AndroidDir + "/gradle/wrapper/gradle-wrapper.jar"
+	dry = NewDryRun(fs)
+	dry.WriteFile(jar, []byte("PK\x00changed"))
+	changes, _ = dry.Changes()
+	if want := "Binary files a/" + jar + " and b/" + jar + " differ\n"; len(changes) != 1 || string(changes[0].Diff) != want {
+		t.Errorf("want %s got %+v", want, changes)
+	}
+}
+
+func TestManifest(t *testing.T) {
+	src := `<?xml version="1.0" encoding="utf-8"?>
+<manifest xmlns:android="http://schemas.android.com/apk/res/android">
+    <!-- comments & formatting are kept -->
+    <application android:label="@string/app_name">
+        <activity
+            android:name=".Main" />
+    </application>
+</manifest>
+`
+	m, err := ParseManifest([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(m.Bytes()); got != src {
+		t.Fatalf("want unchanged manifest got\n%s", got)
+	}
+
+	link := IntentFilter{
+		Actions:    []string{"android.intent.action.VIEW"},
+		Categories: []string{"android.intent.category.DEFAULT", "android.intent.category.BROWSABLE"},
+		Data:       []IntentData{{Scheme: "https", Host: "example.com", PathPrefix: "/items"}},
+	}
+	// every edit is done twice to check that they are idempotent
+	for j := 0; j < 2; j++ {
+		m.AddPermission("CAMERA")
+		m.AddPermission("com.example.permission.SYNC")
+		if _, err := m.SetMetaData("com.example.API_KEY", "a&b"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := m.AddIntentFilter(".Main", link); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := `<?xml version="1.0" encoding="utf-8"?>
+<manifest xmlns:android="http://schemas.android.com/apk/res/android">
+    <!-- comments & formatting are kept -->
+    <uses-permission android:name="android.permission.CAMERA" />
+    <uses-permission android:name="com.example.permission.SYNC" />
+    <application android:label="@string/app_name">
+        <meta-data android:name="com.example.API_KEY" android:value="a&amp;b" />
+        <activity
+            android:name=".Main">
+            <intent-filter>
+                <action android:name="android.intent.action.VIEW" />
+                <category android:name="android.intent.category.DEFAULT" />
+                <category android:name="android.intent.category.BROWSABLE" />
+                <data android:scheme="https" android:host="example.com" android:pathPrefix="/items" />
+            </intent-filter>
+        </activity>
+    </application>
+</manifest>
+`
+	if got := string(m.Bytes()); got != want {
+		t.Fatalf("want manifest\n%s\ngot\n%s", want, got)
+	}
+	if _, err := ParseManifest(m.Bytes()); err != nil {
+		t.Errorf("edited manifest is not well formed: %s", err)
+	}
+
+	// filters added next to existing ones get the same indentation
+	m2, err := ParseManifest([]byte(want))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mail := IntentFilter{Actions: []string{"android.intent.action.SEND"}}
+	if _, err := m2.AddIntentFilter(".Main", mail); err != nil {
+		t.Fatal(err)
+	}
+	filters := `            </intent-filter>
+            <intent-filter>
+                <action android:name="android.intent.action.SEND" />
+            </intent-filter>
+        </activity>`
+	if got := string(m2.Bytes()); !strings.Contains(got, filters) {
+		t.Errorf("want manifest with\n%s\ngot\n%s", filters, got)
+	}
+	if got := m.Permissions(); !reflect.DeepEqual(got, []string{"android.permission.CAMERA", "com.example.permission.SYNC"}) {
+		t.Errorf("unexpected permissions %v", got)
+	}
+
+	// the order of the elements of intent filters does not matter
+	link.Categories[0], link.Categories[1] = link.Categories[1], link.Categories[0]
+	for j := 0; j < 2; j++ {
+		m.RemovePermission("CAMERA")
+		m.RemovePermission("com.example.permission.SYNC")
+		m.RemoveMetaData("com.example.API_KEY")
+		if _, err := m.RemoveIntentFilter(".Main", link); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want = strings.Replace(src, ".Main\" />", ".Main\">\n        </activity>", 1)
+	if got := string(m.Bytes()); got != want {
+		t.Errorf("want manifest\n%s\ngot\n%s", want, got)
+	}
+
+	if _, err := m.AddIntentFilter(".Missing", link); err == nil {
+		t.Error("want error adding an intent filter to a missing activity")
+	}
+	if _, err := ParseManifest([]byte("<manifest><application></manifest>")); err == nil {
+		t.Error("want error parsing a malformed manifest")
+	}
+}
+
+// TestGolden compares the generated projects with the golden files in
+// testdata/golden. Run go test -update after intended changes to the
+// templates and review the diff of the golden files.
+func TestGolden(t *testing.T) {
+	for _, test := range []struct {
+		dir         string
+		webview     string
+		keepalive   bool
+		permissions []string
+		metaData    map[string]string
+		keystore    string
+	}{
+		{"system", "system", false, nil, nil, ""},
+		{"keepalive", "system", true, []string{"CAMERA", "INTERNET", "com.example.permission.SYNC"}, map[string]string{"com.example.API_KEY": "key"}, "release.jks"},
+	} {
+		cfg := testConfig
+		cfg.WebView, cfg.KeepAlive, cfg.Permissions, cfg.MetaData = test.webview, test.keepalive, test.permissions, test.metaData
+		if test.keystore != "" {
+			cfg.Keystore, cfg.KeyAlias, cfg.Version, cfg.VersionCode = test.keystore, "release", "2.1.0", 7
+		}
+		fs := NewMemFS()
+		if err := Generate(context.Background(), cfg, fs); err != nil {
+			t.Fatal(err)
+		}
+		dir := filepath.Join("testdata", "golden", test.dir)
+		if *update {
+			os.RemoveAll(dir)
+		}
+		for fpath, got := range fs.Files {
+			if fpath == PristineFile {
+				// recorded copies of the files compared below
+				continue
+			}
+			golden := filepath.Join(dir, filepath.FromSlash(fpath)+".golden")
+			if *update {
+				os.MkdirAll(filepath.Dir(golden), 0775)
+				if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+				continue
+			}
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Errorf("%s: unexpected file %s", test.dir, fpath)
+				continue
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s: %s differs from %s:\n%s", test.dir, fpath, golden, got)
+			}
+		}
+		filepath.Walk(dir, func(fpath string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			rel, _ := filepath.Rel(dir, strings.TrimSuffix(fpath, ".golden"))
+			if _, ok := fs.Files[filepath.ToSlash(rel)]; !ok {
+				t.Errorf("%s: missing file %s", test.dir, rel)
+			}
+			return nil
+		})
+	}
+}
+
+func TestJavaPackage(t *testing.T) {
+	tests := map[string]string{
+		"github.com/srinathh/mobilehtml5app": "com.github.srinathh.mobilehtml5app",
+		"example.com/my-app/v2":              "com.example.my_app.v2",
+		"example.com/2048":                   "com.example.x2048",
+		"example.com/Native/new":             "com.example.native_.new_",
+		"gopkg.in/yaml.v3":                   "in.gopkg.yaml_v3",
+		"myapp":                              "myapp",
+	}
+	for in, want := range tests {
+		if got := JavaPackage(in); got != want {
+			t.Errorf("JavaPackage(%s): want %s got %s", in, want, got)
+		}
+	}
+}
+
+func TestDetectPackage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gopackage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("// example\nmodule \"example.com/apps\" // apps\n\ngo 1.22\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	sub := filepath.Join(dir, "my-app", "v2")
+	if err := os.MkdirAll(sub, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	importPath, name, err := DetectPackage(sub, "")
+	if err != nil || importPath != "example.com/apps/my-app/v2" || name != "myapp" {
+		t.Errorf("want example.com/apps/my-app/v2 myapp got %s %s %v", importPath, name, err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(sub, "app.go"), []byte("package webapp\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	importPath, name, err = DetectPackage(sub, "example.org/2048")
+	if err != nil || importPath != "example.org/2048" || name != "webapp" {
+		t.Errorf("want example.org/2048 webapp got %s %s %v", importPath, name, err)
+	}
+	if name := goPackageName("example.org/2048"); name != "app2048" {
+		t.Errorf("want package name app2048 got %s", name)
+	}
+}
+
+// webappTestText is run against the rendered webapp.go by TestWebapp
+const webappTestText = `package testapp
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestNewApp(t *testing.T) {
+	app := NewApp()
+	root, err := app.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer app.Stop()
+
+	for path, want := range map[string]string{
+		"/":                   "/hello/Namaste/Alice",
+		"/hello/Namaste/Alice": "Namaste Alice!",
+	} {
+		resp, err := http.Get(root + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || !strings.Contains(string(b), want) {
+			t.Errorf("%s: want %s got %d %s", path, want, resp.StatusCode, b)
+		}
+	}
+
+	if page, err := app.ResolveLink("myapp://unknown"); err != nil || page != "/" {
+		t.Errorf("ResolveLink: want / got %s %v", page, err)
+	}
+
+	dir, err := ioutil.TempDir("", "shares")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := app.ReceiveShares(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := app.ReceiveShares(dir); err != nil {
+		t.Errorf("ReceiveShares twice: %s", err)
+	}
+	id := app.Shares().Begin("", "shared text")
+	if err := app.Shares().Commit(id); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Get(root + "/_native/shares")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(b), "shared text") {
+		t.Errorf("/_native/shares: want shared text got %d %s", resp.StatusCode, b)
+	}
+
+	resp, err = http.Get(root + "/_version")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var v struct {
+		Version     string
+		VersionCode int
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Version != Version || v.VersionCode != VersionCode || Version != "1.0" {
+		t.Errorf("/_version: want 1.0 %d got %+v", VersionCode, v)
+	}
+}
+`
+
+// TestWebapp builds the rendered webapp.go and runs webappTestText against it
+// since the golden files only show that the text has not changed.
+func TestWebapp(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go test of the rendered webapp in short mode")
+	}
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	files, err := Render(testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the rendered package must be inside this repository to import it
+	dir, err := ioutil.TempDir("testdata", "webapp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files["webapp_test.go"] = []byte(webappTestText)
+	for _, name := range []string{"webapp.go", "version.go", "webapp_test.go"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), files[name], 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cmd := exec.Command(gobin, "test", ".")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("go test of the rendered webapp failed: %s\n%s", err, out)
+	}
+}
@@ -0,0 +1,115 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg" // JPEG sources are decoded but icons are encoded as PNG
+	"image/png"
+	"path"
+	"regexp"
+
+	"github.com/disintegration/imaging"
+)
+
+// DefaultIconBackground is the colour of the background layer of adaptive
+// icons if Config.IconBackground is empty
+const DefaultIconBackground = "#FFFFFF"
+
+var validColor = regexp.MustCompile(`^#([0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+
+// density is a screen density bucket of Android resources
+type density struct {
+	name  string
+	scale float64
+}
+
+var densities = []density{
+	{"mdpi", 1},
+	{"hdpi", 1.5},
+	{"xhdpi", 2},
+	{"xxhdpi", 3},
+	{"xxxhdpi", 4},
+}
+
+// Sizes in dp of the launcher icons. The foreground layer of adaptive icons
+// is larger than the icon since launchers mask and move it and only the inner
+// circle of iconSafeDP is guaranteed to be visible.
+const (
+	iconDP       = 48
+	foregroundDP = 108
+	iconSafeDP   = 66
+)
+
+const resDir = AndroidDir + "/src/main/res"
+
+// Icons returns the launcher icon resources rendered from the PNG or JPEG
+// image src keyed by their paths like Render. The legacy icon is rendered for
+// every mipmap density along with the foreground layer of the adaptive icon
+// while its background layer is the colour cfg.IconBackground. If cfg.Splash
+// is set, a splash drawable showing the icon on that colour is added along
+// with SplashTheme using it as the window background of the activity.
+func Icons(src []byte, cfg Config) (map[string][]byte, error) {
+	cfg = cfg.WithDefaults()
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(src))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode icon: %s", err)
+	}
+
+	files := make(map[string][]byte)
+	for _, d := range densities {
+		dir := path.Join(resDir, "mipmap-"+d.name)
+		icon, err := encodeIcon(img, px(iconDP, d), px(iconDP, d))
+		if err != nil {
+			return nil, err
+		}
+		files[path.Join(dir, "ic_launcher.png")] = icon
+		fg, err := encodeIcon(img, px(foregroundDP, d), px(iconSafeDP, d))
+		if err != nil {
+			return nil, err
+		}
+		files[path.Join(dir, "ic_launcher_foreground.png")] = fg
+	}
+
+	templates := map[string]string{
+		resDir + "/mipmap-anydpi-v26/ic_launcher.xml": adaptiveIconDotXMLText,
+		resDir + "/values/colors.xml":                 colorsDotXMLText,
+	}
+	if cfg.Splash != "" {
+		templates[resDir+"/drawable/splash.xml"] = splashDotXMLText
+		templates[resDir+"/values/styles.xml"] = stylesDotXMLText
+	}
+	for fpath, text := range templates {
+		b, err := execTemplate(path.Base(fpath), text, newParams(cfg))
+		if err != nil {
+			return nil, err
+		}
+		files[fpath] = b
+	}
+	return files, nil
+}
+
+// px returns the pixels of dp at the density d
+func px(dp int, d density) int {
+	return int(float64(dp) * d.scale)
+}
+
+// encodeIcon returns img scaled to fit within fit pixels centered on a
+// transparent square of size pixels encoded as PNG
+func encodeIcon(img image.Image, size, fit int) ([]byte, error) {
+	width, height := fit, 0
+	if b := img.Bounds(); b.Dy() > b.Dx() {
+		width, height = 0, fit
+	}
+	dst := imaging.New(size, size, color.NRGBA{})
+	dst = imaging.PasteCenter(dst, imaging.Resize(img, width, height, imaging.Lanczos))
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, dst); err != nil {
+		return nil, fmt.Errorf("could not encode icon: %s", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,439 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// AndroidNS is the XML namespace of the android: attributes of manifests
+const AndroidNS = "http://schemas.android.com/apk/res/android"
+
+// manifestIndent is the indentation added for each level of new elements
+const manifestIndent = "    "
+
+// Manifest is a parsed AndroidManifest.xml. The edits are idempotent and
+// leave the rest of the file, including its formatting and comments, as it
+// was parsed.
+type Manifest struct {
+	root     *xmlNode
+	prefixes map[string]string
+}
+
+// xmlNode is an element or any other token of an XML document. The source
+// of tokens and of the start and end tags of elements is kept so that nodes
+// which are not edited are written back as they were parsed.
+type xmlNode struct {
+	elem     bool
+	name     string
+	attr     []xml.Attr
+	raw      string
+	end      string
+	children []*xmlNode
+}
+
+// IntentFilter is an intent-filter element of an activity
+type IntentFilter struct {
+	Actions    []string
+	Categories []string
+	Data       []IntentData
+}
+
+// IntentData is a data element of an IntentFilter. Empty fields are omitted.
+type IntentData struct {
+	Scheme, Host, PathPrefix, MimeType string
+}
+
+// ParseManifest parses the AndroidManifest.xml b.
+func ParseManifest(b []byte) (*Manifest, error) {
+	m := &Manifest{
+		root:     &xmlNode{},
+		prefixes: map[string]string{AndroidNS: "android"},
+	}
+	dec := xml.NewDecoder(bytes.NewReader(b))
+	stack := []*xmlNode{m.root}
+	var start int64
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not parse manifest: %s", err)
+		}
+		offset := dec.InputOffset()
+		raw := string(b[start:offset])
+		start = offset
+		parent := stack[len(stack)-1]
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			for _, a := range t.Attr {
+				if a.Name.Space == "xmlns" {
+					m.prefixes[a.Value] = a.Name.Local
+				}
+			}
+			n := &xmlNode{elem: true, name: t.Name.Local, attr: t.Attr, raw: raw}
+			parent.children = append(parent.children, n)
+			stack = append(stack, n)
+		case xml.EndElement:
+			// self-closing elements have an empty end tag
+			parent.end = raw
+			stack = stack[:len(stack)-1]
+		default:
+			parent.children = append(parent.children, &xmlNode{raw: raw})
+		}
+	}
+	if m.element("manifest") == nil {
+		return nil, fmt.Errorf("could not find the manifest element")
+	}
+	return m, nil
+}
+
+// Bytes returns the manifest as XML
+func (m *Manifest) Bytes() []byte {
+	var buf bytes.Buffer
+	for _, n := range m.root.children {
+		m.write(&buf, n)
+	}
+	return buf.Bytes()
+}
+
+func (m *Manifest) write(buf *bytes.Buffer, n *xmlNode) {
+	if n.raw != "" {
+		buf.WriteString(n.raw)
+	} else {
+		buf.WriteString("<" + n.name)
+		for _, a := range n.attr {
+			name := a.Name.Local
+			if prefix, ok := m.prefixes[a.Name.Space]; ok {
+				name = prefix + ":" + name
+			}
+			buf.WriteString(" " + name + `="`)
+			xml.EscapeText(buf, []byte(a.Value))
+			buf.WriteString(`"`)
+		}
+		if len(n.children) == 0 {
+			buf.WriteString(" />")
+			return
+		}
+		buf.WriteString(">")
+	}
+	for _, c := range n.children {
+		m.write(buf, c)
+	}
+	if n.raw == "" {
+		buf.WriteString("</" + n.name + ">")
+	} else {
+		buf.WriteString(n.end)
+	}
+}
+
+// element returns the element at the path of element names from the root
+func (m *Manifest) element(names ...string) *xmlNode {
+	n := m.root
+	for _, name := range names {
+		n = n.child(name, "")
+		if n == nil {
+			return nil
+		}
+	}
+	return n
+}
+
+// child returns the first child element called name with the given
+// android:name or any android:name if that is empty
+func (n *xmlNode) child(name, androidName string) *xmlNode {
+	for _, c := range n.children {
+		if c.elem && c.name == name && (androidName == "" || c.androidAttr("name") == androidName) {
+			return c
+		}
+	}
+	return nil
+}
+
+func (n *xmlNode) androidAttr(local string) string {
+	for _, a := range n.attr {
+		if a.Name.Space == AndroidNS && a.Name.Local == local {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// newElement returns an element with android: attributes given as pairs of
+// local names and values
+func newElement(name string, attrs ...string) *xmlNode {
+	n := &xmlNode{elem: true, name: name}
+	for j := 0; j+1 < len(attrs); j += 2 {
+		n.attr = append(n.attr, xml.Attr{Name: xml.Name{Space: AndroidNS, Local: attrs[j]}, Value: attrs[j+1]})
+	}
+	return n
+}
+
+// indent returns the indentation of the children of n
+func (n *xmlNode) indent(parentIndent string) string {
+	for j, c := range n.children {
+		if c.elem && j > 0 && !n.children[j-1].elem {
+			ws := n.children[j-1].raw
+			if k := strings.LastIndex(ws, "\n"); k >= 0 && strings.TrimSpace(ws) == "" {
+				return ws[k+1:]
+			}
+		}
+	}
+	return parentIndent + manifestIndent
+}
+
+// insert adds c to n at index j of its children preceded by a newline and
+// indentation or at the end if j is -1. Elements added to empty elements are
+// indented by one more level than parentIndent.
+func (n *xmlNode) insert(c *xmlNode, j int, parentIndent string) {
+	indent := n.indent(parentIndent)
+	ws := &xmlNode{raw: "\n" + indent}
+	if n.raw != "" && n.end == "" {
+		// expand a self-closing element
+		n.raw = strings.TrimSuffix(strings.TrimSuffix(n.raw, "/>"), " ") + ">"
+		n.end = "</" + n.name + ">"
+	}
+	if j < 0 {
+		// before the whitespace preceding the end tag
+		j = len(n.children) - 1
+		if j < 0 || n.children[j].elem || strings.TrimSpace(n.children[j].raw) != "" {
+			j = len(n.children)
+			n.children = append(n.children, &xmlNode{raw: "\n" + parentIndent})
+		}
+		n.children = append(n.children[:j], append([]*xmlNode{ws, c}, n.children[j:]...)...)
+		return
+	}
+	n.children = append(n.children[:j], append([]*xmlNode{ws, c}, n.children[j:]...)...)
+}
+
+// before returns the index for insert to add children before the first child
+// element called name or the first child element if name is empty. It returns
+// -1 if there is no such element.
+func (n *xmlNode) before(name string) int {
+	for j, c := range n.children {
+		if !c.elem || (name != "" && c.name != name) {
+			continue
+		}
+		if j > 0 && !n.children[j-1].elem && strings.TrimSpace(n.children[j-1].raw) == "" {
+			j--
+		}
+		return j
+	}
+	return -1
+}
+
+// remove removes the child c of n along with the whitespace preceding it
+func (n *xmlNode) remove(c *xmlNode) {
+	for j, cc := range n.children {
+		if cc != c {
+			continue
+		}
+		from := j
+		if j > 0 && !n.children[j-1].elem && strings.TrimSpace(n.children[j-1].raw) == "" {
+			from = j - 1
+		}
+		n.children = append(n.children[:from], n.children[j+1:]...)
+		return
+	}
+}
+
+// Permissions returns the names of the uses-permission elements
+func (m *Manifest) Permissions() []string {
+	var perms []string
+	for _, c := range m.element("manifest").children {
+		if c.elem && c.name == "uses-permission" {
+			perms = append(perms, c.androidAttr("name"))
+		}
+	}
+	return perms
+}
+
+// permissionName returns the full name of perm where names without a package
+// such as CAMERA refer to android.permission.CAMERA
+func permissionName(perm string) string {
+	if !strings.Contains(perm, ".") {
+		return "android.permission." + perm
+	}
+	return perm
+}
+
+// AddPermission adds a uses-permission element for perm after the existing
+// ones unless there is one already and reports whether it did.
+func (m *Manifest) AddPermission(perm string) bool {
+	perm = permissionName(perm)
+	manifest := m.element("manifest")
+	if manifest.child("uses-permission", perm) != nil {
+		return false
+	}
+	at := manifest.before("application")
+	for j, c := range manifest.children {
+		if c.elem && c.name == "uses-permission" {
+			at = j + 1
+		}
+	}
+	manifest.insert(newElement("uses-permission", "name", perm), at, "")
+	return true
+}
+
+// RemovePermission removes the uses-permission element for perm and reports
+// whether there was one.
+func (m *Manifest) RemovePermission(perm string) bool {
+	manifest := m.element("manifest")
+	c := manifest.child("uses-permission", permissionName(perm))
+	if c == nil {
+		return false
+	}
+	manifest.remove(c)
+	return true
+}
+
+// SetMetaData sets the meta-data element called name of the application to
+// value and reports whether it changed.
+func (m *Manifest) SetMetaData(name, value string) (bool, error) {
+	app := m.element("manifest", "application")
+	if app == nil {
+		return false, fmt.Errorf("could not find the application element")
+	}
+	c := app.child("meta-data", name)
+	if c != nil && c.androidAttr("value") == value {
+		return false, nil
+	}
+	n := newElement("meta-data", "name", name, "value", value)
+	if c != nil {
+		*c = *n
+		return true, nil
+	}
+	// meta-data goes after the existing ones before the components of the
+	// application
+	at := app.before("")
+	for j, cc := range app.children {
+		if cc.elem && cc.name == "meta-data" {
+			at = j + 1
+		}
+	}
+	app.insert(n, at, manifestIndent)
+	return true, nil
+}
+
+// RemoveMetaData removes the meta-data element called name of the application
+// and reports whether there was one.
+func (m *Manifest) RemoveMetaData(name string) bool {
+	app := m.element("manifest", "application")
+	if app == nil {
+		return false
+	}
+	c := app.child("meta-data", name)
+	if c == nil {
+		return false
+	}
+	app.remove(c)
+	return true
+}
+
+// activity returns the activity element called name
+func (m *Manifest) activity(name string) (*xmlNode, error) {
+	app := m.element("manifest", "application")
+	if app != nil {
+		if a := app.child("activity", name); a != nil {
+			return a, nil
+		}
+	}
+	return nil, fmt.Errorf("could not find the activity %s", name)
+}
+
+// key returns a string identifying f regardless of the order of its elements
+func (f IntentFilter) key() string {
+	var parts []string
+	for _, a := range f.Actions {
+		parts = append(parts, "action "+a)
+	}
+	for _, c := range f.Categories {
+		parts = append(parts, "category "+c)
+	}
+	for _, d := range f.Data {
+		parts = append(parts, "data "+d.Scheme+" "+d.Host+" "+d.PathPrefix+" "+d.MimeType)
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, "\n")
+}
+
+// intentFilter returns the IntentFilter of the intent-filter element n
+func intentFilter(n *xmlNode) IntentFilter {
+	var f IntentFilter
+	for _, c := range n.children {
+		switch {
+		case !c.elem:
+		case c.name == "action":
+			f.Actions = append(f.Actions, c.androidAttr("name"))
+		case c.name == "category":
+			f.Categories = append(f.Categories, c.androidAttr("name"))
+		case c.name == "data":
+			f.Data = append(f.Data, IntentData{c.androidAttr("scheme"), c.androidAttr("host"), c.androidAttr("pathPrefix"), c.androidAttr("mimeType")})
+		}
+	}
+	return f
+}
+
+// findIntentFilter returns the intent-filter element of activity equal to f
+func findIntentFilter(activity *xmlNode, f IntentFilter) *xmlNode {
+	key := f.key()
+	for _, c := range activity.children {
+		if c.elem && c.name == "intent-filter" && intentFilter(c).key() == key {
+			return c
+		}
+	}
+	return nil
+}
+
+// AddIntentFilter adds f to the activity called name, such as .Main, unless it
+// has an equal intent-filter and reports whether it did.
+func (m *Manifest) AddIntentFilter(name string, f IntentFilter) (bool, error) {
+	activity, err := m.activity(name)
+	if err != nil {
+		return false, err
+	}
+	if findIntentFilter(activity, f) != nil {
+		return false, nil
+	}
+
+	// the indentation of the children of the activity within application
+	indent := activity.indent(manifestIndent + manifestIndent)
+	n := newElement("intent-filter")
+	for _, a := range f.Actions {
+		n.insert(newElement("action", "name", a), -1, indent)
+	}
+	for _, c := range f.Categories {
+		n.insert(newElement("category", "name", c), -1, indent)
+	}
+	for _, d := range f.Data {
+		var attrs []string
+		for _, a := range [][2]string{{"scheme", d.Scheme}, {"host", d.Host}, {"pathPrefix", d.PathPrefix}, {"mimeType", d.MimeType}} {
+			if a[1] != "" {
+				attrs = append(attrs, a[0], a[1])
+			}
+		}
+		n.insert(newElement("data", attrs...), -1, indent)
+	}
+	activity.insert(n, -1, manifestIndent+manifestIndent)
+	return true, nil
+}
+
+// RemoveIntentFilter removes the intent-filter equal to f from the activity
+// called name and reports whether there was one.
+func (m *Manifest) RemoveIntentFilter(name string, f IntentFilter) (bool, error) {
+	activity, err := m.activity(name)
+	if err != nil {
+		return false, err
+	}
+	c := findIntentFilter(activity, f)
+	if c == nil {
+		return false, nil
+	}
+	activity.remove(c)
+	return true, nil
+}
@@ -0,0 +1,152 @@
+package generator
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Labels of the conflict markers written by merge3
+const (
+	mineLabel   = "yours"
+	baseLabel   = "generated"
+	theirsLabel = "upgrade"
+)
+
+// merge3 merges the changes made from base to mine and from base to theirs
+// line by line. Regions changed differently in both are marked like diff3
+// does and reported by returning true.
+func merge3(base, mine, theirs []byte) ([]byte, bool) {
+	b, m, t := splitLines(base), splitLines(mine), splitLines(theirs)
+	mm, tm := matchLines(b, m), matchLines(b, t)
+
+	var out bytes.Buffer
+	conflict := false
+	i, j, k := 0, 0, 0
+	for i < len(b) || j < len(m) || k < len(t) {
+		// lines unchanged in both are copied
+		if i < len(b) && mm[i] == j && tm[i] == k {
+			out.WriteString(b[i])
+			i, j, k = i+1, j+1, k+1
+			continue
+		}
+
+		// otherwise find the next base line unchanged in both
+		i2 := i
+		for i2 < len(b) && (mm[i2] < 0 || tm[i2] < 0) {
+			i2++
+		}
+		j2, k2 := len(m), len(t)
+		if i2 < len(b) {
+			j2, k2 = mm[i2], tm[i2]
+		}
+
+		bc, mc, tc := b[i:i2], m[j:j2], t[k:k2]
+		switch {
+		case equalLines(mc, bc):
+			writeLines(&out, tc)
+		case equalLines(tc, bc), equalLines(mc, tc):
+			writeLines(&out, mc)
+		default:
+			conflict = true
+			writeMarker(&out, "<<<<<<< "+mineLabel)
+			writeLines(&out, mc)
+			writeMarker(&out, "||||||| "+baseLabel)
+			writeLines(&out, bc)
+			writeMarker(&out, "=======")
+			writeLines(&out, tc)
+			writeMarker(&out, ">>>>>>> "+theirsLabel)
+		}
+		i, j, k = i2, j2, k2
+	}
+	return out.Bytes(), conflict
+}
+
+// commonLines returns the longest common subsequence of the lines of a and b
+func commonLines(a, b []byte) []byte {
+	al := splitLines(a)
+	var out bytes.Buffer
+	for i, j := range matchLines(al, splitLines(b)) {
+		if j >= 0 {
+			out.WriteString(al[i])
+		}
+	}
+	return out.Bytes()
+}
+
+// splitLines splits b after each newline
+func splitLines(b []byte) []string {
+	if len(b) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(b), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// matchLines returns for each line of a the index of the line of b it is
+// matched to by a longest common subsequence of a and b or -1
+func matchLines(a, b []string) []int {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	match := make([]int, len(a))
+	i, j := 0, 0
+	for i < len(a) {
+		switch {
+		case j < len(b) && a[i] == b[j]:
+			match[i] = j
+			i, j = i+1, j+1
+		case j < len(b) && lcs[i][j+1] > lcs[i+1][j]:
+			j++
+		default:
+			match[i] = -1
+			i++
+		}
+	}
+	return match
+}
+
+func equalLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for j := range a {
+		if a[j] != b[j] {
+			return false
+		}
+	}
+	return true
+}
+
+func writeLines(out *bytes.Buffer, lines []string) {
+	for _, line := range lines {
+		out.WriteString(line)
+	}
+}
+
+// writeMarker writes a conflict marker line making sure it starts on a new
+// line
+func writeMarker(out *bytes.Buffer, marker string) {
+	if out.Len() > 0 && out.Bytes()[out.Len()-1] != '\n' {
+		out.WriteByte('\n')
+	}
+	out.WriteString(marker + "\n")
+}
@@ -0,0 +1,169 @@
+package generator
+
+import (
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// javaKeywords are reserved words which can't be used as parts of a Java
+// package name
+var javaKeywords = map[string]bool{
+	"abstract": true, "assert": true, "boolean": true, "break": true, "byte": true,
+	"case": true, "catch": true, "char": true, "class": true, "const": true,
+	"continue": true, "default": true, "do": true, "double": true, "else": true,
+	"enum": true, "extends": true, "false": true, "final": true, "finally": true,
+	"float": true, "for": true, "goto": true, "if": true, "implements": true,
+	"import": true, "instanceof": true, "int": true, "interface": true, "long": true,
+	"native": true, "new": true, "null": true, "package": true, "private": true,
+	"protected": true, "public": true, "return": true, "short": true, "static": true,
+	"strictfp": true, "super": true, "switch": true, "synchronized": true, "this": true,
+	"throw": true, "throws": true, "transient": true, "true": true, "try": true,
+	"void": true, "volatile": true, "while": true, "_": true,
+}
+
+// JavaPackage maps a Go import path to a Java package name by reversing the
+// domain and appending the remaining path elements. Following the Java naming
+// conventions, parts are lower cased, characters other than letters, digits
+// and underscores become underscores and keywords get an underscore suffix.
+// Parts starting with a digit are prefixed with x rather than an underscore
+// since Android application IDs require each part to start with a letter.
+func JavaPackage(goImportPath string) string {
+	parts := strings.Split(goImportPath, "/")
+	domainparts := strings.Split(parts[0], ".")
+	var ret []string
+	for j := len(domainparts) - 1; j >= 0; j-- {
+		ret = append(ret, domainparts[j])
+	}
+	ret = append(ret, parts[1:]...)
+
+	for j, part := range ret {
+		part = strings.Map(func(r rune) rune {
+			if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' {
+				return r
+			}
+			return '_'
+		}, strings.ToLower(part))
+		switch {
+		case part == "":
+			part = "x"
+		case part[0] >= '0' && part[0] <= '9' || part[0] == '_':
+			part = "x" + part
+		case javaKeywords[part]:
+			part = part + "_"
+		}
+		ret[j] = part
+	}
+	return strings.Join(ret, ".")
+}
+
+// gomobileJavaPackage returns the Java package gomobile bind generates for
+// the Go package named pkgName. Like Java class names, keywords get an
+// underscore suffix so the native package is bound as native_.
+func gomobileJavaPackage(pkgName string) string {
+	if javaKeywords[pkgName] {
+		return pkgName + "_"
+	}
+	return pkgName
+}
+
+// DetectPackage returns the import path and package name of the Go package in
+// dir. The import path is module if it is not empty or is derived from the
+// nearest go.mod or, outside of a module, from GOPATH. The package name is
+// taken from the Go files in dir and otherwise derived from the import path.
+func DetectPackage(dir, module string) (string, string, error) {
+	importPath := module
+	if importPath == "" {
+		var err error
+		if importPath, err = moduleImportPath(dir); err != nil {
+			return "", "", err
+		}
+	}
+	if importPath == "" {
+		pkg, err := build.ImportDir(dir, build.FindOnly)
+		if err != nil || pkg.ImportPath == "" || pkg.ImportPath == "." {
+			return "", "", fmt.Errorf("could not derive the package path of %s outside of a Go module or GOPATH", dir)
+		}
+		importPath = pkg.ImportPath
+	}
+
+	if pkg, err := build.ImportDir(dir, 0); err == nil && pkg.Name != "" {
+		return importPath, pkg.Name, nil
+	}
+	return importPath, goPackageName(importPath), nil
+}
+
+// moduleImportPath returns the import path of dir within the module declared
+// by the nearest go.mod in dir or its parents or "" if there is none
+func moduleImportPath(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	var rel []string
+	for {
+		b, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			mod := modulePath(b)
+			if mod == "" {
+				return "", fmt.Errorf("no module directive in %s", filepath.Join(dir, "go.mod"))
+			}
+			for j := len(rel) - 1; j >= 0; j-- {
+				mod = mod + "/" + rel[j]
+			}
+			return mod, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		rel = append(rel, filepath.Base(dir))
+		dir = parent
+	}
+}
+
+// modulePath returns the path in the module directive of the go.mod contents
+// b or "" if there is none
+func modulePath(b []byte) string {
+	for _, line := range strings.Split(string(b), "\n") {
+		if j := strings.Index(line, "//"); j >= 0 {
+			line = line[:j]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "module" {
+			if mod, err := strconv.Unquote(fields[1]); err == nil {
+				return mod
+			}
+			return fields[1]
+		}
+	}
+	return ""
+}
+
+// goPackageName returns the default package name for importPath which is its
+// last element without a major version suffix, lower cased and stripped of
+// characters not allowed in identifiers
+func goPackageName(importPath string) string {
+	elems := strings.Split(importPath, "/")
+	last := elems[len(elems)-1]
+	if len(elems) > 1 && len(last) > 1 && last[0] == 'v' && strings.Trim(last[1:], "0123456789") == "" {
+		last = elems[len(elems)-2]
+	}
+	last = strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return -1
+	}, strings.ToLower(last))
+	if last == "" || last[0] >= '0' && last[0] <= '9' {
+		last = "app" + last
+	}
+	return last
+}
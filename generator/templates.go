@@ -0,0 +1,807 @@
+package generator
+
+const webapp = `
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/srinathh/mobilehtml5app/contextrouter"
+	"github.com/srinathh/mobilehtml5app/native"
+	"github.com/srinathh/mobilehtml5app/server"
+	"golang.org/x/net/context"
+)
+
+// App implements a web server backend for an android app
+type App struct {
+	srv    *server.Server
+	links  *contextrouter.LinkRouter
+	shares *native.ShareReceiver
+}
+
+// NewApp returns an App
+func NewApp() *App {
+	srv := server.NewServer()
+	srv.Router.HandleFunc(contextrouter.GET, "/", index)
+	srv.Router.HandleFunc(contextrouter.GET, "/hello/:hellostring/:name", hello)
+	srv.Router.HandleFunc(contextrouter.GET, "/_version", version)
+	srv.WebView.Mount(srv.Router)
+
+	// routes can't be added once the server runs so the shares are mounted
+	// here and stored once ReceiveShares sets their folder
+	shares := native.NewShareReceiver(srv.Events)
+	shares.Mount(srv.Router)
+
+	// Deep links from the deepLinks of mobilehtml5app.json are resolved to
+	// in-app URLs here, for instance with
+	// links.Handle("myapp://items/:itemid", contextrouter.LinkTo("/items/:itemid"))
+	links := contextrouter.NewLinkRouter()
+	links.NotFound = contextrouter.LinkTo("/")
+	return &App{
+		srv:    srv,
+		links:  links,
+		shares: shares,
+	}
+}
+
+// Start is called by the native portion of the webapp to start the web server.
+// It returns the server root URL (without the trailing slash) and any errors.
+func (app *App) Start() (string, error) {
+	return app.srv.Start("127.0.0.1:0")
+}
+
+// Stop is called by the native portion of the webapp to stop the web server.
+func (app *App) Stop() {
+	app.srv.Stop(time.Millisecond * 100)
+}
+
+// Detach is called by the native portion of the webapp instead of Stop when the
+// app is kept alive in the background by a foreground service.
+func (app *App) Detach() {
+	app.srv.Detach()
+}
+
+// Attach is called by the native portion of the webapp when the app returns to
+// the foreground after Detach.
+func (app *App) Attach() {
+	app.srv.Attach()
+}
+
+// RunTask is called by the native portion of the webapp to run background work
+// registered with app.srv.RegisterTask, for instance from a WorkManager worker
+// while the UI is closed. The web server does not need to be started.
+func (app *App) RunTask(name, argsJSON string, timeoutMillis int) error {
+	return app.srv.RunTask(name, argsJSON, time.Now().Add(time.Duration(timeoutMillis)*time.Millisecond))
+}
+
+// SetWebView is called by the native portion of the webapp to let the backend
+// navigate, reload or run scripts in the WebView through app.srv.WebView.
+// Pass null to detach the WebView when it is destroyed.
+func (app *App) SetWebView(v native.WebView) {
+	app.srv.WebView.Attach(v)
+}
+
+// ResolveLink is called by the native portion of the webapp with the deep link
+// the app was opened with. It returns the in-app URL the WebView loads.
+func (app *App) ResolveLink(rawurl string) (string, error) {
+	return app.links.Resolve(rawurl)
+}
+
+// ReceiveShares is called by the native portion of the webapp with a folder of
+// its data folder to store content shared by other apps in. The shares are
+// published to the frontend under /_native/shared and /_native/shares.
+func (app *App) ReceiveShares(dir string) error {
+	return app.shares.SetDir(dir)
+}
+
+// Shares returns the ShareReceiver the native portion of the webapp passes
+// shared content to once ReceiveShares set its folder.
+func (app *App) Shares() *native.ShareReceiver {
+	return app.shares
+}
+
+// version serves the version of the app from mobilehtml5app.json which is
+// generated into version.go
+func version(_ context.Context, w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"version":     Version,
+		"versionCode": VersionCode,
+	})
+}
+
+// These two are autogenerated sample handlers for your webapp to get you started.
+// The sample routes are kept under /hello since the router does not allow
+// wildcards next to static routes such as /_version or /_native.
+
+func index(_ context.Context, w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte("<html><body><div><a href='/hello/Namaste/Alice'>Alice</a></div><div><a href='/hello/Hello/Bob'>Bob</a></div></body></html>"))
+}
+
+func hello(c context.Context, w http.ResponseWriter, r *http.Request) {
+	name := c.Value("name")
+	greetstring := c.Value("hellostring")
+	fmt.Fprintf(w, "<html><body><div>%s %s!</div><div><a href='/'>Back</a></div></body></html>", greetstring, name)
+}
+`
+
+const mainDotJavaText = `
+package {{.PkgPath}};
+
+import android.app.Activity;
+{{- if .KeepAlive}}
+import android.content.ComponentName;
+import android.content.Context;
+{{- end}}
+import android.content.Intent;
+{{- if .KeepAlive}}
+import android.content.ServiceConnection;
+import android.content.pm.PackageManager;
+import android.os.Build;
+import android.os.IBinder;
+{{- end}}
+{{- if .Share}}
+import android.database.Cursor;
+import android.net.Uri;
+import android.os.ParcelFileDescriptor;
+import android.provider.OpenableColumns;
+{{- end}}
+import android.os.Bundle;
+import android.view.KeyEvent;
+import android.webkit.WebSettings;
+import android.webkit.WebViewClient;
+import android.widget.Toast;
+
+import android.webkit.WebView;
+{{- if and .Share (not .KeepAlive)}}
+import java.io.File;
+{{- end}}
+import java.net.URI;
+{{- if .Share}}
+import java.util.ArrayList;
+{{- end}}
+{{- if not .KeepAlive}}
+import {{.GoJavaPkg}}.App;
+import {{.GoJavaPkg}}.{{.ClsName}};
+{{- end}}
+
+public class Main extends Activity {
+    private WebView mWebView;
+    private final WebViewBridge mBridge = new WebViewBridge();
+    // mLink is the deep link the app was opened with until it is loaded
+    private String mLink;
+{{- if .Share}}
+    // mShare is the SEND intent the app was opened with until it is received
+    private Intent mShare;
+{{- end}}
+{{- if .KeepAlive}}
+    // POST_NOTIFICATIONS is named by its string as Manifest.permission only
+    // declares it when compiling against API 33 or higher
+    private static final String POST_NOTIFICATIONS = "android.permission.POST_NOTIFICATIONS";
+    private static final int REQUEST_NOTIFICATIONS = 1;
+    private KeepAliveService mService;
+
+    // The App is owned by KeepAliveService. We load the page once we are bound to it.
+    private final ServiceConnection mConnection = new ServiceConnection() {
+        @Override
+        public void onServiceConnected(ComponentName name, IBinder binder) {
+            mService = ((KeepAliveService.LocalBinder) binder).getService();
+            mService.attach();
+            mService.setWebView(mBridge);
+{{- if .Share}}
+            receiveShare();
+{{- end}}
+            try {
+                loadPage(mService.url());
+            } catch (Exception e) {
+                Toast.makeText(Main.this,"Error:"+e.toString(),Toast.LENGTH_LONG).show();
+                e.printStackTrace();
+                Main.this.finish();
+            }
+        }
+
+        @Override
+        public void onServiceDisconnected(ComponentName name) {
+            mService = null;
+        }
+    };
+{{- else}}
+	private App mSrv;
+{{- end}}
+
+    @Override
+    protected void onCreate(Bundle savedInstanceState) {
+        super.onCreate(savedInstanceState);
+        mWebView = new WebView(this);
+		WebSettings webSettings = mWebView.getSettings();
+		webSettings.setJavaScriptEnabled(true);
+		mWebView.setWebViewClient(new WebViewClient());
+        setContentView(mWebView);
+        mLink = deepLink(getIntent());
+{{- if .Share}}
+        // a recreated Activity must not receive the share it was opened with again
+        mShare = savedInstanceState == null ? getIntent() : null;
+{{- end}}
+{{- if .KeepAlive}}
+        // Since API 33 the notification of the service is only shown once the
+        // user grants the permission at runtime
+        if (Build.VERSION.SDK_INT >= 33 && checkSelfPermission(POST_NOTIFICATIONS) != PackageManager.PERMISSION_GRANTED) {
+            requestPermissions(new String[]{POST_NOTIFICATIONS}, REQUEST_NOTIFICATIONS);
+        }
+        Intent intent = new Intent(this, KeepAliveService.class);
+        startForegroundService(intent);
+        bindService(intent, mConnection, Context.BIND_AUTO_CREATE);
+{{- else}}
+        mSrv = {{.ClsName}}.newApp();
+        mSrv.setWebView(mBridge);
+{{- if .Share}}
+        try {
+            mSrv.receiveShares(new File(getFilesDir(), "shares").getPath());
+        } catch (Exception e) {
+            e.printStackTrace();
+        }
+        receiveShare();
+{{- end}}
+{{- end}}
+    }
+{{if .KeepAlive}}
+	// The server keeps running in the background and is only marked as detached
+    @Override
+    protected void onResume() {
+        super.onResume();
+        if (mService != null) {
+            mService.attach();
+        }
+    }
+
+    @Override
+    protected void onPause() {
+        super.onPause();
+        if (mService != null) {
+            mService.detach();
+        }
+    }
+
+    // The service and with it the server is stopped when the user leaves the app
+    @Override
+    protected void onDestroy() {
+        super.onDestroy();
+        if (mService != null) {
+            mService.setWebView(null);
+        }
+        unbindService(mConnection);
+        if (isFinishing()) {
+            stopService(new Intent(this, KeepAliveService.class));
+        }
+    }
+{{- else}}
+	// We start the server on onResume
+    @Override
+    protected void onResume() {
+        super.onResume();
+        try {
+			loadPage(mSrv.start());
+        } catch (Exception e) {
+            Toast.makeText(this,"Error:"+e.toString(),Toast.LENGTH_LONG).show();
+            e.printStackTrace();
+            this.finish();
+        }
+    }
+
+    // Send a graceful shut down signal to the server. onPause is guaranteed
+	// to be called by Android while onStop or onDestroy may not be called.
+    @Override
+    protected void onPause() {
+        super.onPause();
+		mSrv.stop();
+    }
+
+    // The backend must not call into the destroyed WebView
+    @Override
+    protected void onDestroy() {
+        super.onDestroy();
+        mSrv.setWebView(null);
+    }
+{{- end}}
+
+    // Deep links opening the running app replace the current page
+    @Override
+    protected void onNewIntent(Intent intent) {
+        super.onNewIntent(intent);
+        setIntent(intent);
+        mLink = deepLink(intent);
+{{- if .Share}}
+        mShare = intent;
+{{- if .KeepAlive}}
+        if (mService != null) {
+            receiveShare();
+        }
+{{- else}}
+        receiveShare();
+{{- end}}
+{{- end}}
+{{- if .KeepAlive}}
+        if (mLink != null && mService != null) {
+            try {
+                loadPage(mService.url());
+            } catch (Exception e) {
+                e.printStackTrace();
+            }
+        }
+{{- end}}
+    }
+
+    // deepLink returns the link of a VIEW intent or null for other intents
+    private static String deepLink(Intent intent) {
+        if (intent == null || !Intent.ACTION_VIEW.equals(intent.getAction()) || intent.getData() == null) {
+            return null;
+        }
+        return intent.getData().toString();
+    }
+{{- if .Share}}
+
+    // receiveShare passes the text and streams of a pending SEND intent to the
+    // ShareReceiver of the backend which publishes them to the frontend
+    private void receiveShare() {
+        Intent intent = mShare;
+        mShare = null;
+        if (intent == null || !(Intent.ACTION_SEND.equals(intent.getAction()) || Intent.ACTION_SEND_MULTIPLE.equals(intent.getAction()))) {
+            return;
+        }
+        native_.ShareReceiver shares = {{if .KeepAlive}}mService{{else}}mSrv{{end}}.shares();
+        if (shares == null) {
+            return;
+        }
+        String id = shares.begin(extra(intent, Intent.EXTRA_SUBJECT), extra(intent, Intent.EXTRA_TEXT));
+        try {
+            ArrayList<Uri> streams = new ArrayList<Uri>();
+            if (Intent.ACTION_SEND_MULTIPLE.equals(intent.getAction())) {
+                ArrayList<Uri> uris = intent.getParcelableArrayListExtra(Intent.EXTRA_STREAM);
+                if (uris != null) {
+                    streams.addAll(uris);
+                }
+            } else {
+                Uri uri = intent.getParcelableExtra(Intent.EXTRA_STREAM);
+                if (uri != null) {
+                    streams.add(uri);
+                }
+            }
+            for (Uri uri : streams) {
+                ParcelFileDescriptor pfd = getContentResolver().openFileDescriptor(uri, "r");
+                String type = getContentResolver().getType(uri);
+                shares.addFD(id, pfd.detachFd(), displayName(uri), type == null ? "" : type);
+            }
+            shares.commit(id);
+        } catch (Exception e) {
+            shares.abort(id);
+            Toast.makeText(this,"Error:"+e.toString(),Toast.LENGTH_LONG).show();
+            e.printStackTrace();
+        }
+    }
+
+    private static String extra(Intent intent, String name) {
+        CharSequence s = intent.getCharSequenceExtra(name);
+        return s == null ? "" : s.toString();
+    }
+
+    // displayName returns the name the sending app gives the stream at uri
+    private String displayName(Uri uri) {
+        Cursor c = getContentResolver().query(uri, new String[]{OpenableColumns.DISPLAY_NAME}, null, null, null);
+        if (c != null) {
+            try {
+                if (c.moveToFirst() && !c.isNull(0)) {
+                    return c.getString(0);
+                }
+            } finally {
+                c.close();
+            }
+        }
+        String name = uri.getLastPathSegment();
+        return name == null ? "file" : name;
+    }
+{{- end}}
+
+    // loadPage loads the in-app URL the backend resolves a pending deep link
+    // to or the start page of the server at root
+    private void loadPage(String root) {
+        String page = "/";
+        if (mLink != null) {
+            try {
+                page = {{if .KeepAlive}}mService{{else}}mSrv{{end}}.resolveLink(mLink);
+            } catch (Exception e) {
+                e.printStackTrace();
+            }
+            mLink = null;
+        }
+        mWebView.loadUrl(root + page);
+    }
+
+    // WebViewBridge lets the backend navigate, reload or run scripts in the
+    // WebView through the native WebView interface. Go calls it from its own
+    // threads so the work is posted to the UI thread.
+    private class WebViewBridge implements native_.WebView {
+        @Override
+        public void navigate(final String rawurl) {
+            runOnUiThread(new Runnable() {
+                @Override
+                public void run() {
+                    String current = mWebView.getUrl();
+                    mWebView.loadUrl(current == null ? rawurl : URI.create(current).resolve(rawurl).toString());
+                }
+            });
+        }
+
+        @Override
+        public void reload() {
+            runOnUiThread(new Runnable() {
+                @Override
+                public void run() {
+                    mWebView.reload();
+                }
+            });
+        }
+
+        @Override
+        public void evaluateJavascript(final String snippet) {
+            runOnUiThread(new Runnable() {
+                @Override
+                public void run() {
+                    mWebView.evaluateJavascript(snippet, null);
+                }
+            });
+        }
+    }
+
+    // We override back key press to close the app rather than pass it to the WebView
+    @Override
+    public boolean dispatchKeyEvent(KeyEvent event) {
+        if(event.getKeyCode() == KeyEvent.KEYCODE_BACK){
+            this.finish();
+            return true;
+        }
+        return super.dispatchKeyEvent(event);
+    }
+}
+`
+const keepAliveServiceDotJavaText = `
+package {{.PkgPath}};
+
+import android.app.Notification;
+import android.app.NotificationChannel;
+import android.app.NotificationManager;
+import android.app.PendingIntent;
+import android.app.Service;
+import android.content.Intent;
+import android.content.pm.ServiceInfo;
+import android.os.Binder;
+import android.os.Build;
+import android.os.IBinder;
+{{- if .Share}}
+
+import java.io.File;
+{{- end}}
+
+import {{.GoJavaPkg}}.App;
+import {{.GoJavaPkg}}.{{.ClsName}};
+
+// KeepAliveService owns the Go App and keeps the server running in the
+// foreground while the Activity is in the background.
+public class KeepAliveService extends Service {
+    private static final int NOTIFICATION_ID = 1;
+    private static final String CHANNEL_ID = "keepalive";
+
+    private final IBinder mBinder = new LocalBinder();
+    private App mSrv;
+    private String mURL;
+    private Exception mError;
+
+    public class LocalBinder extends Binder {
+        KeepAliveService getService() {
+            return KeepAliveService.this;
+        }
+    }
+
+    @Override
+    public void onCreate() {
+        super.onCreate();
+        mSrv = {{.ClsName}}.newApp();
+{{- if .Share}}
+        try {
+            mSrv.receiveShares(new File(getFilesDir(), "shares").getPath());
+        } catch (Exception e) {
+            e.printStackTrace();
+        }
+{{- end}}
+        try {
+            mURL = mSrv.start();
+        } catch (Exception e) {
+            mError = e;
+            e.printStackTrace();
+        }
+    }
+
+    @Override
+    public int onStartCommand(Intent intent, int flags, int startId) {
+        if (Build.VERSION.SDK_INT >= Build.VERSION_CODES.Q) {
+            startForeground(NOTIFICATION_ID, notification(), ServiceInfo.FOREGROUND_SERVICE_TYPE_DATA_SYNC);
+        } else {
+            startForeground(NOTIFICATION_ID, notification());
+        }
+        return START_STICKY;
+    }
+
+    @Override
+    public IBinder onBind(Intent intent) {
+        return mBinder;
+    }
+
+    // Send a graceful shut down signal to the server when the service is stopped.
+    @Override
+    public void onDestroy() {
+        mSrv.stop();
+        super.onDestroy();
+    }
+
+    // url returns the root URL of the server or the error starting it failed with
+    public String url() throws Exception {
+        if (mError != null) {
+            throw mError;
+        }
+        return mURL;
+    }
+
+    public void attach() {
+        mSrv.attach();
+    }
+
+    public void detach() {
+        mSrv.detach();
+    }
+
+    // resolveLink returns the in-app URL for a deep link the app was opened with
+    public String resolveLink(String link) throws Exception {
+        return mSrv.resolveLink(link);
+    }
+
+    // setWebView attaches the WebView of the Activity to the App or detaches it with null
+    public void setWebView(native_.WebView v) {
+        mSrv.setWebView(v);
+    }
+{{- if .Share}}
+
+    // shares returns the ShareReceiver SEND intents received by the Activity are passed to
+    public native_.ShareReceiver shares() {
+        return mSrv.shares();
+    }
+{{- end}}
+
+    private Notification notification() {
+        NotificationManager manager = (NotificationManager) getSystemService(NOTIFICATION_SERVICE);
+        manager.createNotificationChannel(new NotificationChannel(CHANNEL_ID, getString(R.string.app_name), NotificationManager.IMPORTANCE_LOW));
+        PendingIntent open = PendingIntent.getActivity(this, 0, new Intent(this, Main.class), PendingIntent.FLAG_IMMUTABLE);
+        return new Notification.Builder(this, CHANNEL_ID)
+                .setContentTitle(getString(R.string.app_name))
+                .setSmallIcon(R.drawable.ic_launcher)
+                .setContentIntent(open)
+                .setOngoing(true)
+                .build();
+    }
+}
+`
+const gitignore = `androidapp.iml
+.gradle/
+.idea/
+local.properties
+build/
+libs/backend.aar
+libs/backend.aar.sha256
+libs/backend-sources.jar
+`
+
+// versionDotGoText embeds the version of the app from the project
+// configuration in the Go package
+const versionDotGoText = `
+// Code generated by mobilehtml5app from mobilehtml5app.json. DO NOT EDIT.
+
+package {{.PkgName}}
+
+// Version and VersionCode are the version of the app. Run mobilehtml5app
+// version bump to change them.
+const (
+	Version     = "{{.Version}}"
+	VersionCode = {{.VersionCode}}
+)
+`
+
+const settingsDotGradleText = `
+rootProject.name = '{{.Name}}'
+`
+
+const buildDotGradleText = `
+buildscript {
+    repositories {
+        google()
+        mavenCentral()
+    }
+    dependencies {
+        classpath 'com.android.tools.build:gradle:{{.Plugin}}'
+    }
+}
+
+apply plugin: 'com.android.application'
+
+android {
+    namespace '{{.PkgPath}}'
+    compileSdk {{.APILevel}}
+
+    defaultConfig {
+        applicationId '{{.PkgPath}}'
+        minSdk {{.MinSDK}}
+        targetSdk {{.APILevel}}
+        versionCode {{.VersionCode}}
+        versionName '{{groovyString .Version}}'
+    }
+{{- if .Keystore}}
+
+    // the passwords are read from the environment so that they are not
+    // committed along with the project
+    signingConfigs {
+        release {
+            storeFile file('{{groovyString .Keystore}}')
+            storePassword System.getenv('{{.StorePasswordEnv}}')
+            keyAlias '{{groovyString .KeyAlias}}'
+            keyPassword System.getenv('{{.KeyPasswordEnv}}')
+        }
+    }
+{{- end}}
+
+    buildTypes {
+        release {
+            minifyEnabled true
+            proguardFiles getDefaultProguardFile('proguard-android-optimize.txt')
+{{- if .Keystore}}
+            signingConfig signingConfigs.release
+{{- end}}
+        }
+    }
+}
+
+repositories {
+    google()
+    mavenCentral()
+}
+
+dependencies {
+    implementation files('libs/backend.aar')
+}
+
+// genGoMobileAAR binds the webapp in the parent folder along with the native
+// package into libs/backend.aar before every build. mobilehtml5app bind skips
+// the bind if no Go source, module or bind option changed since the last one.
+tasks.register('genGoMobileAAR', Exec) {
+    workingDir '..'
+    commandLine 'mobilehtml5app', 'bind'
+}
+
+preBuild.dependsOn('genGoMobileAAR')
+`
+
+const gradleWrapperDotPropertiesText = `
+distributionBase=GRADLE_USER_HOME
+distributionPath=wrapper/dists
+{{- with .GradleSHA256}}
+distributionSha256Sum={{.}}
+{{- end}}
+distributionUrl=https\://services.gradle.org/distributions/gradle-{{.Gradle}}-bin.zip
+zipStoreBase=GRADLE_USER_HOME
+zipStorePath=wrapper/dists
+`
+
+const androidManifestDotXMLText = `
+<?xml version="1.0" encoding="utf-8"?>
+<manifest xmlns:android="http://schemas.android.com/apk/res/android">
+
+    <uses-permission android:name="android.permission.INTERNET" />
+{{- if .KeepAlive}}
+    <uses-permission android:name="android.permission.FOREGROUND_SERVICE" />
+    <uses-permission android:name="android.permission.FOREGROUND_SERVICE_DATA_SYNC" />
+    <uses-permission android:name="android.permission.POST_NOTIFICATIONS" />
+{{- end}}
+
+    <!-- The WebView loads pages over plain http from the server on 127.0.0.1 -->
+    <application
+        android:label="@string/app_name"
+        android:icon="{{.Icon}}"
+        android:usesCleartextTraffic="true">
+        <activity
+            android:name=".Main"
+{{- if .DeepLinks}}
+            android:launchMode="singleTask"
+{{- end}}
+{{- if .Splash}}
+            android:theme="@style/SplashTheme"
+{{- end}}
+            android:exported="true">
+            <intent-filter>
+                <action android:name="android.intent.action.MAIN" />
+                <category android:name="android.intent.category.LAUNCHER" />
+            </intent-filter>
+        </activity>
+{{- if .KeepAlive}}
+        <service
+            android:name=".KeepAliveService"
+            android:exported="false"
+            android:foregroundServiceType="dataSync" />
+{{- end}}
+    </application>
+</manifest>
+`
+
+const stringsDotXMLText = `
+<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <string name="app_name">{{androidString .Title}}</string>
+</resources>
+`
+
+// icLauncherDotXMLText is a placeholder launcher icon drawn as a vector so that
+// no binary resources need to be generated. It is also the small icon of the
+// notification of the KeepAliveService.
+const icLauncherDotXMLText = `
+<?xml version="1.0" encoding="utf-8"?>
+<vector xmlns:android="http://schemas.android.com/apk/res/android"
+    android:width="48dp"
+    android:height="48dp"
+    android:viewportWidth="48"
+    android:viewportHeight="48">
+    <path
+        android:fillColor="#00ADD8"
+        android:pathData="M24,2A22,22 0,1 1,24 46A22,22 0,1 1,24 2Z" />
+    <path
+        android:fillColor="#FFFFFF"
+        android:pathData="M14,16h20v4h-20zM14,22h20v4h-20zM14,28h14v4h-14z" />
+</vector>
+`
+
+const adaptiveIconDotXMLText = `
+<?xml version="1.0" encoding="utf-8"?>
+<adaptive-icon xmlns:android="http://schemas.android.com/apk/res/android">
+    <background android:drawable="@color/ic_launcher_background" />
+    <foreground android:drawable="@mipmap/ic_launcher_foreground" />
+</adaptive-icon>
+`
+
+const colorsDotXMLText = `
+<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <color name="ic_launcher_background">{{.IconBackground}}</color>
+{{- if .Splash}}
+    <color name="splash_background">{{.Splash}}</color>
+{{- end}}
+</resources>
+`
+
+// splashDotXMLText is the window background of the activity shown until the
+// WebView draws the first page
+const splashDotXMLText = `
+<?xml version="1.0" encoding="utf-8"?>
+<layer-list xmlns:android="http://schemas.android.com/apk/res/android">
+    <item android:drawable="@color/splash_background" />
+    <item>
+        <bitmap
+            android:gravity="center"
+            android:src="@mipmap/ic_launcher_foreground" />
+    </item>
+</layer-list>
+`
+
+const stylesDotXMLText = `
+<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <style name="SplashTheme" parent="android:Theme.NoTitleBar">
+        <item name="android:windowBackground">@drawable/splash</item>
+    </style>
+</resources>
+`
@@ -0,0 +1,221 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"unicode/utf8"
+
+	"golang.org/x/net/context"
+)
+
+// PristineFile is the name of the file in the project folder recording the
+// files of the project as they were last generated. Upgrade uses it as the
+// base of three-way merges and it should be committed along with the project.
+const PristineFile = ".mobilehtml5app.pristine.json"
+
+// MergeSuffix is appended to the path of a file with conflicting changes to
+// name the file Upgrade writes the merged text with conflict markers to
+const MergeSuffix = ".merge"
+
+// ErrNotGenerated is returned by Upgrade if the project folder does not
+// contain an Android project
+var ErrNotGenerated = errors.New("no " + AndroidDir + " to upgrade")
+
+// UpgradeResult reports the changes made by Upgrade
+type UpgradeResult struct {
+	// Added, Updated and Removed are the files written or removed
+	Added, Updated, Removed []string
+	// Conflicts are the files with local changes conflicting with changes
+	// to the generated files. They are left untouched.
+	Conflicts []Conflict
+}
+
+// Conflict is a file that could not be upgraded
+type Conflict struct {
+	Path   string
+	Reason string
+	// Merged is set for conflicting changes to text files. It holds the
+	// changes to the generated file merged into the local file with
+	// conflicting regions marked like diff3 does with the local, last
+	// generated and upgraded lines. It is written to MergePath.
+	Merged    []byte
+	MergePath string
+}
+
+// Upgrade regenerates the project in fs with the current templates and cfg.
+// Files that were not changed since they were last generated are replaced and
+// local changes are merged with the changes to the generated files. Files
+// with conflicting changes are reported and left untouched while the merged
+// text is written next to them with MergeSuffix. Their last generated version
+// is kept as the base of the next merge so that the upgrade can be run again
+// once the conflicts are resolved by hand, which removes the merged text.
+// Binary files such as icons are not merged but replaced only if they were
+// not changed locally.
+func Upgrade(c context.Context, cfg Config, fs FS) (*UpgradeResult, error) {
+	if _, err := fs.ReadFile(AndroidDir + "/build.gradle"); os.IsNotExist(err) {
+		return nil, ErrNotGenerated
+	}
+	files, err := project(cfg, fs)
+	if err != nil {
+		return nil, err
+	}
+	pristine, err := loadPristine(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &UpgradeResult{}
+	for _, fpath := range sortedNames(files, pristine) {
+		select {
+		case <-c.Done():
+			return res, c.Err()
+		default:
+		}
+
+		theirs, generated := files[fpath]
+		base, recorded := pristine[fpath]
+		mine, err := fs.ReadFile(fpath)
+		exists := err == nil
+		if err != nil && !os.IsNotExist(err) {
+			return res, err
+		}
+
+		switch {
+		case !generated:
+			// no longer generated: remove the file unless it was changed
+			switch {
+			case !exists:
+			case bytes.Equal(mine, base):
+				if err := fs.Remove(fpath); err != nil {
+					return res, err
+				}
+				res.Removed = append(res.Removed, fpath)
+			default:
+				res.Conflicts = append(res.Conflicts, Conflict{Path: fpath, Reason: "changed locally but no longer generated"})
+			}
+			delete(pristine, fpath)
+			continue
+
+		case !exists && recorded:
+			// removed locally: keep it removed
+			if !bytes.Equal(base, theirs) {
+				res.Conflicts = append(res.Conflicts, Conflict{Path: fpath, Reason: "removed locally but changed by the upgrade"})
+				continue
+			}
+
+		case !exists:
+			if err := writeFile(fs, fpath, theirs); err != nil {
+				return res, err
+			}
+			res.Added = append(res.Added, fpath)
+
+		case bytes.Equal(mine, theirs):
+
+		case isBinary(base) || isBinary(mine) || isBinary(theirs):
+			switch {
+			case recorded && bytes.Equal(mine, base):
+				if err := writeFile(fs, fpath, theirs); err != nil {
+					return res, err
+				}
+				res.Updated = append(res.Updated, fpath)
+			case recorded && bytes.Equal(theirs, base):
+			default:
+				res.Conflicts = append(res.Conflicts, Conflict{Path: fpath, Reason: "binary file changed locally and by the upgrade"})
+				continue
+			}
+
+		default:
+			if !recorded {
+				// generated before files were recorded: the lines both
+				// versions share are the best guess of what was generated
+				base = commonLines(mine, theirs)
+			}
+			merged, conflict := merge3(base, mine, theirs)
+			if conflict {
+				mergePath := fpath + MergeSuffix
+				if err := writeFile(fs, mergePath, merged); err != nil {
+					return res, err
+				}
+				res.Conflicts = append(res.Conflicts, Conflict{Path: fpath, Reason: "conflicting changes", Merged: merged, MergePath: mergePath})
+				continue
+			}
+			if !bytes.Equal(merged, mine) {
+				if err := writeFile(fs, fpath, merged); err != nil {
+					return res, err
+				}
+				res.Updated = append(res.Updated, fpath)
+			}
+		}
+		// the merged text of an earlier conflict is stale once resolved
+		if err := fs.Remove(fpath + MergeSuffix); err != nil && !os.IsNotExist(err) {
+			return res, err
+		}
+		pristine[fpath] = theirs
+	}
+
+	if err := savePristine(fs, pristine); err != nil {
+		return res, err
+	}
+	return res, SaveConfig(fs, cfg.WithDefaults())
+}
+
+// isBinary reports whether b can't be merged line by line because it
+// contains a NUL byte or is not valid UTF-8
+func isBinary(b []byte) bool {
+	return bytes.IndexByte(b, 0) >= 0 || !utf8.Valid(b)
+}
+
+func sortedNames(maps ...map[string][]byte) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, m := range maps {
+		for name := range m {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func writeFile(fs FS, name string, data []byte) error {
+	if err := fs.MkdirAll(path.Dir(name)); err != nil {
+		return fmt.Errorf("unable to create folder for %s: %s", name, err)
+	}
+	if err := fs.WriteFile(name, data); err != nil {
+		return fmt.Errorf("error writing %s: %s", name, err)
+	}
+	return nil
+}
+
+// loadPristine returns the files recorded in PristineFile in fs or an empty
+// map for projects generated before files were recorded
+func loadPristine(fs FS) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	b, err := fs.ReadFile(PristineFile)
+	if os.IsNotExist(err) {
+		return files, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &files); err != nil {
+		return nil, fmt.Errorf("could not decode %s: %s", PristineFile, err)
+	}
+	return files, nil
+}
+
+func savePristine(fs FS, files map[string][]byte) error {
+	b, err := json.MarshalIndent(files, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode %s: %s", PristineFile, err)
+	}
+	return fs.WriteFile(PristineFile, append(b, '\n'))
+}
@@ -0,0 +1,11 @@
+// Code generated by gen_wrapper.go from ../example/basic/androidapp. DO NOT EDIT.
+
+package generator
+
+// gradleWrapper holds the gradle wrapper scripts and jar keyed by their
+// paths relative to AndroidDir
+var gradleWrapper = map[string]string{
+	"gradlew":                           "#!/usr/bin/env bash\n\n##############################################################################\n##\n##  Gradle start up script for UN*X\n##\n##############################################################################\n\n# Add default JVM options here. You can also use JAVA_OPTS and GRADLE_OPTS to pass JVM options to this script.\nDEFAULT_JVM_OPTS=\"\"\n\nAPP_NAME=\"Gradle\"\nAPP_BASE_NAME=`basename \"$0\"`\n\n# Use the maximum available, or set MAX_FD != -1 to use that value.\nMAX_FD=\"maximum\"\n\nwarn ( ) {\n    echo \"$*\"\n}\n\ndie ( ) {\n    echo\n    echo \"$*\"\n    echo\n    exit 1\n}\n\n# OS specific support (must be 'true' or 'false').\ncygwin=false\nmsys=false\ndarwin=false\ncase \"`uname`\" in\n  CYGWIN* )\n    cygwin=true\n    ;;\n  Darwin* )\n    darwin=true\n    ;;\n  MINGW* )\n    msys=true\n    ;;\nesac\n\n# For Cygwin, ensure paths are in UNIX format before anything is touched.\nif $cygwin ; then\n    [ -n \"$JAVA_HOME\" ] && JAVA_HOME=`cygpath --unix \"$JAVA_HOME\"`\nfi\n\n# Attempt to set APP_HOME\n# Resolve links: $0 may be a link\nPRG=\"$0\"\n# Need this for relative symlinks.\nwhile [ -h \"$PRG\" ] ; do\n    ls=`ls -ld \"$PRG\"`\n    link=`expr \"$ls\" : '.*-> \\(.*\\)$'`\n    if expr \"$link\" : '/.*' > /dev/null; then\n        PRG=\"$link\"\n    else\n        PRG=`dirname \"$PRG\"`\"/$link\"\n    fi\ndone\nSAVED=\"`pwd`\"\ncd \"`dirname \\\"$PRG\\\"`/\" >&-\nAPP_HOME=\"`pwd -P`\"\ncd \"$SAVED\" >&-\n\nCLASSPATH=$APP_HOME/gradle/wrapper/gradle-wrapper.jar\n\n# Determine the Java command to use to start the JVM.\nif [ -n \"$JAVA_HOME\" ] ; then\n    if [ -x \"$JAVA_HOME/jre/sh/java\" ] ; then\n        # IBM's JDK on AIX uses strange locations for the executables\n        JAVACMD=\"$JAVA_HOME/jre/sh/java\"\n    else\n        JAVACMD=\"$JAVA_HOME/bin/java\"\n    fi\n    if [ ! -x \"$JAVACMD\" ] ; then\n        die \"ERROR: JAVA_HOME is set to an invalid directory: $JAVA_HOME\n\nPlease set the JAVA_HOME variable in your environment to match the\nlocation of your Java installation.\"\n    fi\nelse\n    JAVACMD=\"java\"\n    which java >/dev/null 2>&1 || die \"ERROR: JAVA_HOME is not set and no 'java' command could be found in your PATH.\n\nPlease set the JAVA_HOME variable in your environment to match the\nlocation of your Java installation.\"\nfi\n\n# Increase the maximum file descriptors if we can.\nif [ \"$cygwin\" = \"false\" -a \"$darwin\" = \"false\" ] ; then\n    MAX_FD_LIMIT=`ulimit -H -n`\n    if [ $? -eq 0 ] ; then\n        if [ \"$MAX_FD\" = \"maximum\" -o \"$MAX_FD\" = \"max\" ] ; then\n            MAX_FD=\"$MAX_FD_LIMIT\"\n        fi\n        ulimit -n $MAX_FD\n        if [ $? -ne 0 ] ; then\n            warn \"Could not set maximum file descriptor limit: $MAX_FD\"\n        fi\n    else\n        warn \"Could not query maximum file descriptor limit: $MAX_FD_LIMIT\"\n    fi\nfi\n\n# For Darwin, add options to specify how the application appears in the dock\nif $darwin; then\n    GRADLE_OPTS=\"$GRADLE_OPTS \\\"-Xdock:name=$APP_NAME\\\" \\\"-Xdock:icon=$APP_HOME/media/gradle.icns\\\"\"\nfi\n\n# For Cygwin, switch paths to Windows format before running java\nif $cygwin ; then\n    APP_HOME=`cygpath --path --mixed \"$APP_HOME\"`\n    CLASSPATH=`cygpath --path --mixed \"$CLASSPATH\"`\n\n    # We build the pattern for arguments to be converted via cygpath\n    ROOTDIRSRAW=`find -L / -maxdepth 1 -mindepth 1 -type d 2>/dev/null`\n    SEP=\"\"\n    for dir in $ROOTDIRSRAW ; do\n        ROOTDIRS=\"$ROOTDIRS$SEP$dir\"\n        SEP=\"|\"\n    done\n    OURCYGPATTERN=\"(^($ROOTDIRS))\"\n    # Add a user-defined pattern to the cygpath arguments\n    if [ \"$GRADLE_CYGPATTERN\" != \"\" ] ; then\n        OURCYGPATTERN=\"$OURCYGPATTERN|($GRADLE_CYGPATTERN)\"\n    fi\n    # Now convert the arguments - kludge to limit ourselves to /bin/sh\n    i=0\n    for arg in \"$@\" ; do\n        CHECK=`echo \"$arg\"|egrep -c \"$OURCYGPATTERN\" -`\n        CHECK2=`echo \"$arg\"|egrep -c \"^-\"`                                 ### Determine if an option\n\n        if [ $CHECK -ne 0 ] && [ $CHECK2 -eq 0 ] ; then                    ### Added a condition\n            eval `echo args$i`=`cygpath --path --ignore --mixed \"$arg\"`\n        else\n            eval `echo args$i`=\"\\\"$arg\\\"\"\n        fi\n        i=$((i+1))\n    done\n    case $i in\n        (0) set -- ;;\n        (1) set -- \"$args0\" ;;\n        (2) set -- \"$args0\" \"$args1\" ;;\n        (3) set -- \"$args0\" \"$args1\" \"$args2\" ;;\n        (4) set -- \"$args0\" \"$args1\" \"$args2\" \"$args3\" ;;\n        (5) set -- \"$args0\" \"$args1\" \"$args2\" \"$args3\" \"$args4\" ;;\n        (6) set -- \"$args0\" \"$args1\" \"$args2\" \"$args3\" \"$args4\" \"$args5\" ;;\n        (7) set -- \"$args0\" \"$args1\" \"$args2\" \"$args3\" \"$args4\" \"$args5\" \"$args6\" ;;\n        (8) set -- \"$args0\" \"$args1\" \"$args2\" \"$args3\" \"$args4\" \"$args5\" \"$args6\" \"$args7\" ;;\n        (9) set -- \"$args0\" \"$args1\" \"$args2\" \"$args3\" \"$args4\" \"$args5\" \"$args6\" \"$args7\" \"$args8\" ;;\n    esac\nfi\n\n# Split up the JVM_OPTS And GRADLE_OPTS values into an array, following the shell quoting and substitution rules\nfunction splitJvmOpts() {\n    JVM_OPTS=(\"$@\")\n}\neval splitJvmOpts $DEFAULT_JVM_OPTS $JAVA_OPTS $GRADLE_OPTS\nJVM_OPTS[${#JVM_OPTS[*]}]=\"-Dorg.gradle.appname=$APP_BASE_NAME\"\n\nexec \"$JAVACMD\" \"${JVM_OPTS[@]}\" -classpath \"$CLASSPATH\" org.gradle.wrapper.GradleWrapperMain \"$@\"\n",
+	"gradlew.bat":                       "@if \"%DEBUG%\" == \"\" @echo off\r\n@rem ##########################################################################\r\n@rem\r\n@rem  Gradle startup script for Windows\r\n@rem\r\n@rem ##########################################################################\r\n\r\n@rem Set local scope for the variables with windows NT shell\r\nif \"%OS%\"==\"Windows_NT\" setlocal\r\n\r\n@rem Add default JVM options here. You can also use JAVA_OPTS and GRADLE_OPTS to pass JVM options to this script.\r\nset DEFAULT_JVM_OPTS=\r\n\r\nset DIRNAME=%~dp0\r\nif \"%DIRNAME%\" == \"\" set DIRNAME=.\r\nset APP_BASE_NAME=%~n0\r\nset APP_HOME=%DIRNAME%\r\n\r\n@rem Find java.exe\r\nif defined JAVA_HOME goto findJavaFromJavaHome\r\n\r\nset JAVA_EXE=java.exe\r\n%JAVA_EXE% -version >NUL 2>&1\r\nif \"%ERRORLEVEL%\" == \"0\" goto init\r\n\r\necho.\r\necho ERROR: JAVA_HOME is not set and no 'java' command could be found in your PATH.\r\necho.\r\necho Please set the JAVA_HOME variable in your environment to match the\r\necho location of your Java installation.\r\n\r\ngoto fail\r\n\r\n:findJavaFromJavaHome\r\nset JAVA_HOME=%JAVA_HOME:\"=%\r\nset JAVA_EXE=%JAVA_HOME%/bin/java.exe\r\n\r\nif exist \"%JAVA_EXE%\" goto init\r\n\r\necho.\r\necho ERROR: JAVA_HOME is set to an invalid directory: %JAVA_HOME%\r\necho.\r\necho Please set the JAVA_HOME variable in your environment to match the\r\necho location of your Java installation.\r\n\r\ngoto fail\r\n\r\n:init\r\n@rem Get command-line arguments, handling Windowz variants\r\n\r\nif not \"%OS%\" == \"Windows_NT\" goto win9xME_args\r\nif \"%@eval[2+2]\" == \"4\" goto 4NT_args\r\n\r\n:win9xME_args\r\n@rem Slurp the command line arguments.\r\nset CMD_LINE_ARGS=\r\nset _SKIP=2\r\n\r\n:win9xME_args_slurp\r\nif \"x%~1\" == \"x\" goto execute\r\n\r\nset CMD_LINE_ARGS=%*\r\ngoto execute\r\n\r\n:4NT_args\r\n@rem Get arguments from the 4NT Shell from JP Software\r\nset CMD_LINE_ARGS=%$\r\n\r\n:execute\r\n@rem Setup the command line\r\n\r\nset CLASSPATH=%APP_HOME%\\gradle\\wrapper\\gradle-wrapper.jar\r\n\r\n@rem Execute Gradle\r\n\"%JAVA_EXE%\" %DEFAULT_JVM_OPTS% %JAVA_OPTS% %GRADLE_OPTS% \"-Dorg.gradle.appname=%APP_BASE_NAME%\" -classpath \"%CLASSPATH%\" org.gradle.wrapper.GradleWrapperMain %CMD_LINE_ARGS%\r\n\r\n:end\r\n@rem End local scope for the variables with windows NT shell\r\nif \"%ERRORLEVEL%\"==\"0\" goto mainEnd\r\n\r\n:fail\r\nrem Set variable GRADLE_EXIT_CONSOLE if you need the _script_ return code instead of\r\nrem the _cmd.exe /c_ return code!\r\nif  not \"\" == \"%GRADLE_EXIT_CONSOLE%\" exit 1\r\nexit /b 1\r\n\r\n:mainEnd\r\nif \"%OS%\"==\"Windows_NT\" endlocal\r\n\r\n:omega\r\n",
+	"gradle/wrapper/gradle-wrapper.jar": "PK\x03\x04\n\x00\x00\b\b\x00\r;\x84B\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\t\x00\x00\x00META-INF/\x03\x00PK\x03\x04\n\x00\x00\b\b\x00\r;\x84BӸ\xe0\x11N\x00\x00\x00h\x00\x00\x00\x14\x00\x00\x00META-INF/MANIFEST.MF\xf3M\xcc\xcbLK-.\xd1\rK-*\xce\xccϳR0\xd43\xe0\xe5\xf2\xcc-\xc8I\xcdM\xcd+I,\x01\n\xea\x86d\x96\xe4\xa4Z)\xb8\x17%\xa6\xe4\xa4b\xc8\"i5\xd3520460\x01BS##S\x13m\x03 \xe0\xe5\xe2\xe5\x02\x00PK\x03\x04\n\x00\x00\b\b\x00\v;\x84B\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x04\x00\x00\x00org/\x03\x00PK\x03\x04\n\x00\x00\b\b\x00\v;\x84B\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\v\x00\x00\x00org/gradle/\x03\x00PK\x03\x04\n\x00\x00\b\b\x00\v;\x84B\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x13\x00\x00\x00org/gradle/wrapper/\x03\x00PK\x03\x04\n\x00\x00\b\b\x00\v;\x84Bh\x82df\xa3\x00\x00\x00\xd5\x00\x00\x00#\x00\x00\x00org/gradle/wrapper/Download$1.class}\x8cM\n\xc20\x10\x85\xdfh5Z+v/\x82\v׆\x9e\xc1\x1fp!.<AlCl\tII\xaa\xbd\x9b\v\x0f\xe0\xa1\xc4\x14q\xeb<\xe6=\x1e\xcc|\xaf\xf7\xe3\t C\xc2\xc0\x18bB|\xb67\x97\xcb}\xa9%a\xba\xb5\xad\xd1V\x14\xebJ\xdc\x05a\xb63\xb9\xb6\xbe4\xea(\x9b\xab-\x18&\x84\x85u\x8a+'\n-y\xebD]K\xc7\x7f\x7f\xab\x8c\x00Br0F\xba\x8d\x16\xdeKOH;\x1a\xd7\xc2(~\xbaT2o\b\xf3?\x90t\x19\x10=|\xa7\x1f\x84\xd0\"\fBF\x18\x06\x1f\x87\x8d\xc3\ru-\x1d}\x00PK\x03\x04\n\x00\x00\b\b\x00\v;\x84B\n\xbc\u0380\x16\x02\x00\x00p\x04\x00\x00D\x00\x00\x00org/gradle/wrapper/Download$SystemPropertiesProxyAuthenticator.class\xa5S\x7fo\x12A\x10}\v\x94\xc3\xe3h\xf1T\xb0\xd6Jmk=\xaar\xc5_\xd1`\x1a\t\xc6Ą\x98&\xd5&\xa6\x89\xc9\x02\x1b8s\xbd#{\x8b\x94o\xa5\xff\xd8F\x13?\x80\x1f\xca8\vT\t\x92b\xe2\xfd\xb13;o\xde\xcc\xdbٽ\x1f?\xbf~\aP\xc6c\x13i\x14LX(\x18XKᦉul\xa4\xb0\x99²vo\x99\xd8\xc2m\x13q8\x06\xb6\r\xdcaH>\xf3\x02O\xed2ĝ\xe2\x01C\xa2\x16\xb6\x04\xc3R\xdd\v\xc4\xeb\xdeQC\xc87\xbc\xe1SĮ\x87M\xee\x1fp\xe9\xe9\xfd8\x98P\x1d/bX\xdf\x1fDJ\x1c\xedɰ+\xa4\xf2DD\xde\xf1\xa0\xdaS\x1d\x11(\xaf\xc9U(\x19\xacWA d\xcd\xe7Q$\x88\xf3\xbc\x1eʶۖ\xbc\xe5\v\xb7/y\x97\xa8\ue2f0\x1f\xf8!om\xce/XaXn\v\xb5G\xe5\xfa\xa1lM`^\x180l8\xc5\xfa\a\xfe\x91\xbb\x81P\xee줊\x81\xbb\f \xf9ιZ\xca\x15=\x98\xd8\xf1\x0eCaN\"\x83\xb9\x1f\xf6dS\xbc\xf4\xf4x2gPIK\xb1\x90\xc1\"\u0558#\x8ba\xb1\xa3T\xb7\xd4\xd5G~\x1b\ti\xe0\x9e\x85\x12\\\xba\x83?\xc0\x19W#;\x06\xca\x16\xee\xe3\x81\xee\xf0\xd0\xc0#\x86\xdd\xff\x1b.C\xee\xb7\xca)`\xf5\xdc\t0d\x87D\x9f\amwԇ!\xad\xefi\xd4m\xc0\xb0\xe5\xd4'R\x94\xf4\x82v\xa5\xf8w\x88\xe1Ɍ\xc4\x7f\xa4f\xa7c$B\x85\xb5\x0e\x97U)9\x89H8\xc5\xc3\x1aC~F\x8bÚ\xbe\xee\x95s\x8e\x895\xfa\xcb,\xe8/\x8e\x98\xbeUzDK\xb4s\xc9ҋ\xc2\xc2\xf6\tb\x9fɉ!Kkr\x18|\x8f\x8b\xb4Z\xa3\x04ظB6\x81\x1c\xf2cr\x95\xa2\x1a\xcb\x7fC\xfc\x9d\x9d8ł\x9d\xb4\x8dS\xa4\xbe\xe0\xc2\t\xccOS\xe5\x1a\x13\xe5\xf2\xa3r\x04fpm\\\xee)\xd9\xd8X\v\x9b\xad%7J\x18k\xd1\xde\n\xae\x0fi\xab\xc3\xfc\x1b\xb8L\xd6&/\x8d\".\xc1\xc4U\xb2Ȧ~\x01PK\x03\x04\n\x00\x00\b\b\x00\v;\x84B\xe7\xecXs\xaa\x00\x00\x00\xdb\x00\x00\x00\"\x00\x00\x00org/gradle/wrapper/IDownload.classE\x8d\xc1\n\xc20\f\x86\xff\xcc\xe9\xe6\x14\x11\xbc\n^\xf5b\xf1\xbc\xab\n\x03AP\xf4^\xb726J;\xeat>\x9b\a\x1f\xc0\x87\x12;\x95\x19\bɗ\xfc\xf9\xf3|\xdd\x1f\x00\x16\xe8{\xe8z\b\b~\xa2+%5O\b\x93\xe9&\xe7WΔ(\xd9a\x17\x85_\xca4[gR\x84\xb3#!X\xddbQ\x94\x99Vg\x0f=\xcb{}1\xb1\xa8\xf7\x84A\xb4\xfcY\xcd\xebC\xc2X\x9b\x94\xa5\x86'R\xb0\xca\xf0\xa2\x10\x865\x1a\xc2\xf0c/\xb9J\xd9\xf6\x94\x8b\xb8$\x8c\xfe\xa3\xe6S\x87@pP\a\xb9\x84\x16\\\xcbmKu\xed\xd8\xf4l\xef\xc0\x7f\x03PK\x03\x04\n\x00\x00\b\b\x00\v;\x84B\xdbHE*\x05\x06\x00\x00P\v\x00\x003\x00\x00\x00org/gradle/wrapper/ExclusiveFileAccessManager.class\x95VKp\x14U\x14=/\x99\xcc/\x1d\x02\x01\x02\x03\x01\x86\x802\t\x84\x89\"\x02\x93\x88\x86\x91@`\x12ȇ@\x00\t\x9dI'i\xd2\xe9\x1e{z\x12\xe2\xff\x87\x7f\xf1\xbb\xb1\xca=K\xa5J\x120\x16\x91*KK˅.)7n\xacr\xa1\xe5\x82rc\x95\xe2yݓ\x90/\x16\x9b\xd7\xef\xddw?\xe7\xde{ޝ\xf9\xfe\xdf/\xae\x03x\x00\x1f\x86Q\x89T\b\x1b\xd1,\x97\x96\x00\x8e\x84\xe1\xc3\xd1\x00Z\xc3\xf0#%\x0fmr\xd7\x1eD\x87\xfc\x1e\x93\x92N\xb9\x1c\x0f\xe3\x04\xba\x028\x19ĩ0J\xa5\xb0\x14\xa7\xc3x\x02g\xc2t\xd5\x1d\xc6Y\xa8\x01\xf4\x04\x91\x96\x86\xbdAhҬ/\x8cr\xf4\x17c\x00z\x18\xe70\x18\x80!%C\xc50a\x05\x90\t\xe0I\x81\xa5\xa9#\xc9\xc3ݍM\xa9\xfd\xdd\xed\xc7\x1a\x1b\x9bN\b\x94\xa5Ω\xc3j\xdcP\xcd\xfex\xbbc\xebf\x7f\x9d@I\xd22\xb3\x8ej:\x9d\xaa\x91\xd3\x04B\x8e>\xa4Y9\xa79+ \x9a\x04\x96d,\xc3h2\x1d\xcd\x1eV\r)\xf4\xd7\xeb\xa6\xee\xec\x15(\x8a55Uu\n\xf8\x92V/\rKS\xba\xa9\xb5\xe4\x86z4\xbbC\xed14\x19\xcfJ\xabF\xa7j\xeb\xf2\x9c\x17\xfa\x9c\x01\x9d^\xe2)\xcb\xee\x8f\xf7\xdbj\xaf\xa1\xc5Gl5\x93\xd1\xec\xf8\xfe\xf3i#\x97Շ\xb5F\xdd\xd0\x1a\xd2i-\x9bmVM\xb5_\xb3\tԯ\xba\x02\x81\x86\x98\x97\x87nť^\x9dw\xca9\xba\x11O[f:gۚ\xe9ē\xaaaȈuU3\xb2>\xd2sNK;t\x16`ʶ\xd3\xe00\xc7C\xc4dX\xe9A\x81\nOӤ\xe3\xf4\x80j\x9a\x9a\x91u#0\x8dA\xda\b\xa2_9\xc3\x19\xd1j\x19G\xb7LYEm&tVm6D\x99\xb6\x9ae\x88\r\xff\x03V (\xb1xN\x96ڪ\xd9k\ry\x85\xf0Dk\xa6\xfd\xb6\u0379\x93I\xe5QO\x87\x99\x97I\xd2;P\xb7|voF3S\xfd\xd9|w\x84\xf5\x1d\x1du{i\x1fj\xd7\xfbM\xd5\xc9\xd94i\xae\xefH\xcc/\xf2\xde{j\x93緊\xab\xc0\xb2!u\xb4GK\x1aVVk\xcd\xe9\x9ac\x8c\x12\xef\x1do\xee\x85\xd7Z\xb2\xcfO\x1c\x96D\x11JO\xc9\x05\x96/\xa0,\x10n\xb7rv:ߡ\r\x8b\x93m\xbb4VP\x05[\xc1\xfdآ \x86-\x02\xca\xccl\x14dA\xf2\xac\x9a\xfb\xa0\xf6\xe5t\xa3W\xa3]\x0e\xc3\nFp\x9e\x9d\xdfn\xa4\a\x15\x8cJA\x15\x9eR\xf04\x9e\t\xe0Y\x05\xcf\xe1y\x81Ջ\xf5S\xa0\xc0\x1eQ\xf0\x02^\f\xe0%\x05/\xe3\x15\x05\xfb\xd1\x18\xc0\x05\x05\xaf\xe25R\xe1N출)_\xed4!\x05\x8a;\xbcW\x1c\xb5\xfa\xa2\x12\xc7\xeb|qQ[S\xd3\x03ZotD\xd5\x1db\x8d\xf6Yvt\x9a\xb7Q\xefyE\x1d+\xda\xc7\xf0\t\x9a\xbd\xe1A~3\x80\xb7\x14\xbc\x8dw\x02\xb8\xa8\xe0]ج\xef\x02\xaf@\xaa\xbe\x17\xc0\xfb\n>\x90*5\xf7\xf4\xbeI\xf6\xb9\x04\x12(\x8c\xc9\x06\x97\xf4k\xceQU\x92%\xff*bUs\x1fW\x80*-\xea\x10/WĪ\x16\x1ar~\x19\xdf\xec%\xaa\xd8\xfc\xeb\xf9\x16\xf9.\xca\xd7\xe8X\x9eH`ӂ|\x9e\xe5H\xd2qh\xb0W\xb7\xb3.\xf8\x93\xb3\xb2j\x1f\xcd:\xda\x10ٝ\xa7\xbe\xecP\xb3n\x18\xba\xa7\xcc1\x14f\x1aɩ'\xbcq*\x93\xbb<\xe2uwU`]\x1c{4厶\xf5\x8bz\xcb\x0f\xb7\x19@;\x06H\x14֪(khZ\x86\x04\x8e\x1d\x92\x99UĚ\xeeV\xa9R\x82o\xe8\xc9ZF\xceю\xaa\xce\x00g\xe5B\xb5\xee\x9cB\xbd\xd8 `<\x0e&cn+\xa7'\xf7\x9a\xc5\xd3`¶fhj\x96NV\xcf\fΌ\xac\x91鉱l\xdel`\xae\xee\xf0\xe0o\xeeF\xfe\x98\x03\x85\x88`\x136C\xe0>\x9e\n\x10F\x99\x9c\x04ܗ\xc9a\xe0j\b\xf2\xbd\x9a\xebV\x9e\xba\xa8S\xc8oi\xf58D\xf5\xda+(\xa8\xae\xb8\x82\xc2ˮ\xf16\xd7\xcc\xc7u#\xd7J\x84\xe8z\t\x9d\xd7P\x12\xf5̰\x1dq\xc0\xdd\xc90\xc2\xdd\xc9@\x05\xdc\xd7\xf2OF\x81\fSp\x96\x7f\x02\x14@TL\xc0\u05f5u\fE\x13\xf0w\x8d#\xc0mp\f\xa1\xb20\x971\x14\x8fCi\xa9\xe1\xed\x18J\x8e\x8b\x84O$\x8a\xaeb\xc9.\xbfH\x04#\xc1o\xd1\xc8C\xb9\xbf\xfas\x14\\P?\xfa\x04\xbb&P\xdaUS\xb6t\x1c\xcb\x12\xbe\x88o\fe\x89\xa2\bM\x97\xbbڷ\x7f\x8c\xd0xE\xc4ǅ&\x85\x17\xaeb\xe5\xa5\xdb\x13\xd2O\xcd\x04ʻ\xf2\x00\xcaV1\xb2t9\x86\xd5e\x11\xee\x89h\xcd\x14\x98\xb5\x93ۮ\xa1B \x11\x8a\x10\xe5:\xd7!1\xb9>\t\xefN\x80H\xe8\xb3Dxa\x9d\xf0d\xc2\x1f\xf1\xdf@\xf9\xc7\bE\xfc_\xa1|RF\x8f\xf8Ǳa2Q<\xc3E\xf1$k\xf4)\xc6q\x935\xbc\x89\x9f\xddo-~\xc0-\xac\xe7\xfe\x96\xfb\xe5Y\x94\xc8{Q\xea~\v\xdd\x1e]d_\xc0\x9aW\xb2\xf2\xd5ln\r\xd7Z\xae;ٝ\xddܥ؇\x13x\x10\xdd\u0601>J\a\xf102\xd8\xc5Q\xbf\x9bc\xba\x1e\x17\xf0\b#70v\x12_rV\xdf@#\xbe\xc6\x01|\x87\x83\x8cߊ\x9f\xd0F\x04I\xfc»_y\xf7\x1b\xef\xfe\xe0\xdd-\x1c\xc2_8\x8c\x7f\x90\x12\x85h!\xa2V\xb1\x82\xba\x92\x1f\xa7ɖ\x9d\xb8Ψ;\x98\xd7nL\xe0!\x9e\x83\xd4_\xcd\xe8\xbb(#\x1b\xa6\xd8\xc3\xddn\xec\x91\xec\xe1.\x81:\xb2\xa6\x12\x7f\x13\xd9\x1efX\x83?\x89o/\x19X\x8b\xdf\xf1(\x1eC\x11\xb1\x82|\x9a\xd2\xdfG\xfd\xa4\xcb\xd8\xc7Ix\xfe\xd6\xe4\xc9}\x8a\xdf\x02\x97\xdcߠ\xa4\xfa\x1a\xa2\x02\x97\xe0K]\xa6\xd8G\xc5\x12\x16\xd4#y9]\x02\xc7)\xed\xa2\xfc4oN\x92\xe8g\xdcDʩ\xb3\x84\xdf\x03\x04\xed\xd1\xfb \x9a$f&/C\x1e\xfe\x0fPK\x03\x04\n\x00\x00\b\b\x00\v;\x84Bc\xaf\x81\xba`\x02\x00\x00\x10\x06\x00\x00-\x00\x00\x00org/gradle/wrapper/WrapperConfiguration.class\x8d\x92\xdbn\xd3@\x10\x86g\x938N\x1d7Ρ--\xe7@\xa1\x8e[\xea\x06\xb8\x00QU\x946\x1c\xa4@QB[\x89\x9b\xcaI\x8c\xeb*ؑ\xed\x80\xc4S\x81D\x84\xc4\x05\x0f\xc0C!f\xd7N\x9b,\x1b\x89\v\xcf\xcc\xce\xce?\xf3y\xec\xdf\x7f~\xfe\x02\x80:<P@\x81Z\x0e\x8c9\x98\x83\xf5\x1clP\x7f\x8f\x9aMjLj\xb6d\xa8\xcbp\x9f\x80\xdas\xc3(p;\xc3\xc8\xf5=\x02\x85\xe6\x99\xf5\xc92=;2\x0f[\xaf\x9e\x10(N\xde?\xb3B\x9b@9\xae\xe9[\x9ec\xb6\xf1\xces\xf8\xba\xb7VtJ@\xfe\xe2\x0eb\x05\x8d\xe2\\v\xdb\xf5\xdch\x87@Z\xaf\x1d\x11\xc8\xec\xf9=\xbcך\xaeg\xbf\x19~\xec\xd8\xc1;\xab\xd3g3\xfc\xae\xd5?\xb2\x02\x97\x9e\x93d&:uC\x02\xb5\xa6\x1f8\xa6\x13X\xbd\xbem~\x0e\xac\xc1\xc0\x0e\xcc\xe3\xd8\xef\xf9\xde\a\xd7\x19\x06\x16\xc5@,ͱ\xa3\xfd\xa97,\xea5\xfe\x1d\xb5\x90/*\xe9\xd35\x94\xb5µ\x8a_ma\xdcnz\x1d\x95PT\xbc\xa8\xff[+j\x1d\xef\x8a\xef\x11g\x15\xac}?^\xac\x12N\x1e⛤,\x9c<\xb4\xfdaе\x9f\xbbt\x8b+\xa2UmR.\x15\xcaP\xc1\r\xbdh\xed\xee7\x1b'\x87\xedF\xeb\xe4\xe5\xc1\xeb\x86\n\xf3P 0?\xde5\xfdԡ\n\x1a\x14T(RS\xa2&\x0f*\x81\xb5\xff\xfc48\xe5b\x13\a\x9d3\xbb\x1bA\x15\xffK\x05\xff`\tR\xb4\x19F):\x98y-\xf1\xc5ė\x98\xcf\x03\xa1\xc8h\x17\xf0t\x8cy\x82\xfe\x9a\xf1\x03\x88QN\x8d m\x943#\x90X\x9ce\xb1\xfc\x8d\xc9\x17\xd1.\xe1 @a\x06#\x05.a\xebe\x8cV\xf0\x01\x1c\xce\x1aav\x19=\xee\f.'CL\xf4\xf4N2\xbeC\xee\xeby\xb3,K^\x9d\x10K\xe7\xe2+\x98\x8f\xc5;X\x9d\xa2\xd5\xc6\xfa\br\x17(\n\xcb\xdeDM\x95uX\x8a\xab\x92\x0e4ʳ\xae\x04\x91\xae\v@\xd2<Ȫ\x10\xe4\x06\x8e\x10\x80\xa4y\x10\x1d5\xb5\x19 \xf4\x83\xd0\xc1U!\x88ăl\bAn\x89A$\x1ed\v5\xf5\x19 Z\x02r[\b\x92\xe5A\x1e\nAV\xc5 Y\x1e\xe4\x11j\x1e\xcf\x00)& w\x84 2\x0f\xb2-\x04\xb9+\x06\x91y\x90\xa7\xa8ٝ\x01RJ@֘F\xff\vPK\x03\x04\n\x00\x00\b\b\x00\v;\x84B\x7f!\xa6\xac\xe6\x04\x00\x00\x19\n\x00\x000\x00\x00\x00org/gradle/wrapper/SystemPropertiesHandler.class\x8dV\xebs\x13U\x14\xffm\x9bd\xd3\xed\xf2*\x94\x92\x8aP\x8a@\xfa\x8c\x80\x15lJUJ+\x95\xbe$P,\x82\xbaMo\x93\x85d7l6\xb4\x15\x15\x9f\xf8F\xfd\xc2\f\x8e\xc3\xd7~r\xa6\x0ec\nvF\xbe\xfbG\xa9\xbf\xbb\tm^ \xcd\xf4\xdc{\xcf\xeb\x9e\xf3;\xe7\x9eٿ\xff\xf9\xf3/\x00\x87\xf1\xb3\x86v\x8c\xa8xCC\x1dF4\x9cƨ\x8a75\xf8%\xf3\x8c\x06\x15c\xf20.w\x13*&UL\xa9xK\xc3&\x8c\x04qV\xae1I\xceIr^\x83\x8e\xe9 .hx\x1b3\xd2\xecb#\xde\xc1\xa5F\\ƻ\x92\xbc'/;/\xa5\xefk00+I\\\xc3\x1c\x84T\x9eoD\x02I\x15\xa6\x8a+\n\x02\x03\xa6e\xba\x83\n\xea\xc3\x1d\xd3\n|C\xf6\x9cP\xb0e̴\xc4D.=+\x9cs\xc6l\x8a\x9c\xa61;n\xa4\xa6\rǔ\xe7\"\xd3\xe7&ͬ\x82\xee1\xdbID\x12\x8e1\x97\x12\x91\x05\xc7\xc8d\x84\x13\x89-e]\x91\x9erl\x1e\\SdO\x1b\x16\xc5NT\xc1\xf6\x84p+\xa5\n\xf6\x86Ǯ\x18\u05cd\x88iGF̔\x88v\x14\x8e9\xd7LEƍ\f킦\x15s\x1da\xa4\x15\x84\xcatG\xadL\xce-\x88\xa8\xa60\xb0\xe6u\xf9\xe8\xe4\xf0b\\d\\Ӷ(\xab\xbf*\x96d.\x9e4eX\x89\b\xcdL+A\x91\x9a6\xdcxR8\xebν\x9b\x1d\x91\x10\x8b\xbc\xdf\x13\xc9\x18\f'\x91K\v\xcb-\xf729{E\xc4]\xca\xeb\xcc\x17\x14\xec(q0\xea\n\xc7pmi\xbb9\xb3\x9e\xae\x8c\x9a\x8c\xf2\x8c\x154\x165\x96\x98\xb0,B%\x02Z\xa6\x04\xb0\x9d%\xe2\r e&\x19\xc3\xe5\xadV\xadL\xa6\n\xa2\xa84/+\xe8R\xe6qQ\xfbʯ\x1d\xa8\x06\xab\x9a3H\x87\r13a\x19nΡ\x8f\xe1\xa7\x17\xf3\x99}j1;\xe7\xc4E\x01\xae\xddOh\xa9^i\xaac?\b\xfd\xb6\x8d{N\x1b\xd9$\xefRqUG\nl\x9a\x1d\xb5\xf0R\xd0\xf2\x84V\x92\x1e-\x1d62:\xaeI\xdf\xdbk\xf4\x94\x82֍\xc0\xcf\xe6,\xd7L\x8b\x12aKeV'sfjN6\xd9\xfeaǱ\x9d\xb6\x85\xa4\xb0\xdaR\xb61GY\xdbFu\xdb\xe6\x19\xcb\t\x1d\x0e\xb2\x92\xb8:r\xb8.\x03ZP\xb05\xbb\x0eå\xdepog\x87\x8aE\x1dK\xf8@\xc7\r|\xa8\xe2#\x1d\x1f㦊Ot|\nf\xf1\x19>\xd7q\x04_\xa8\xf8R\xc7-\xc9\xf9\n_\xab\xf8FǷ\xf8N\xc7\xf7\xf8A\xc5m\x1d?\xe2'\x05\x9d\xcf\xfe\x90\x19He\xff+\xd0K\xb1\xe4p1\x8b\x9d\xce\xe1r\x91ũ\xec\n9pd\xf2\nvm\x88J_\xb3\xd4\xf0\xc7SvV:\x93\xe1X\xd4\xed\tW7KG\x15\xab\x88t\xb4B\xbf\xf8R\x9f\xa6\x1ft\xed\x02\x8b-\x13\xaeV\xa4Fg\x8d\bJ8璎\xbd \x1f\x93\x17\x7f\xcb\x13\xde \x1fj\xdcNg<|\x9e\x92R\xcd\xc7\x1b\xe0\x1c\x8b\tWBZ\xaaF\x16\x85\x9b\xca\x18rn\x16g\x10\x1f}\x99z\xc9lj\xaa\xe62\xbe\xa4\x91\x9d\x10\x8bt\u1cfc\xa5\x1c\x8e\xf5\xa1w\xb44\xfa\xa1\xa4\xe1\xc4ĵ\x9c\xb0\xe2\xa2F\x0e\x1b\xa3\xb4\n\x97\xf1\xc7\x03\xd87o\xca:\xfb\x13\x8e\x9d\xe3\x1cl\x0e\x8f֬\xc2\xd6J\x1eqI\t+\xe1&\xbd\x86\x1b%M\xc8\xfc\x0f\xfeO\xfd\xd7\xf3(\x01\xce\x1b\xc0\xf5lD\x05\xc7k\x98?\x93C\xec\xe3\xaf\x1d\xf2\xaf\x0e\x8a\x9cO\xa4\ax\x8apU\xb8\xfa;W\xa1\xfc\xee\x89\x0f\x92\x06<\xe6\x1e\x1c\"\xd5\v\n\b\xa3\x83k\x03:\xd1E-\x1a+\xf7\xe1C\x90\xbc?\xd6P7\xb3\x8a\xfa\xb1\xce<|\xbf\xc0ߵ\xb2\x06?\x19\x81\xf15\xa83\xf4\x1c\x9c\xe8\xeeɣ\x81\xff\xda2\xf4~\x9f܄|\x8f\x96\xd16\xb1\x06}f\r\x9b\xa8\xbd\xb9iK\x1e[\xe9b[\x1eM=\xab\xd8\xfe\xa8i\xc7\x034Ot\xe7\xb1\xf3!Z\x14\xf4\xfbB\xbe\x87إ\xe0.N\xc9]\x88,\x7fOȟGk\x1e\xcf\xf5\aB\x81<v\xdfŁP\xc0\x97\xc7\xf3\xfdjH\xcdc\xcf=4w\x85\xd4n\xa9\xb5\x97\x8a\x0f\xd1V\x8f\v\xcb\xff>\xe8ZA=\x119\x84^&Ћ\x17\xbd5\x84c\xe8G#%\x12\x84i4\x93\xeec\x8a\xed\xd8B̶1\xe9\x10-\xda\t\xc4!\x82ЅnZu\xd1&B\xab\x1eDy\xba\xc0\x0f\xac\xcb\x1cq7p\x94\x03\xee%\xdcF\x1f\xee\xe0eܣ\xd6\n\xb5\xeeSK\x82:\x0f\xf9a\xd4L\x0f=\xbc-\x8aݴ\x8dpw\a\xad\x8c\xe50?\xbdn\x11\xe8#\xf4\x12\xa0\xaf>z\xeac\t\x928C_\xc7\b;A\xc7q\xbc\xc25\x88\xdf\xe87\xca\"\x85\xf0+\x06p\x82Ź\xcc\xef\xadA\xbcJ\x7f\xaf\x15\vX\xd0z\x9d\xbb\x93^\x91\x87\xb8;\xe5\xed\x86\xff\x03PK\x03\x04\n\x00\x00\b\b\x00\v;\x84B+Nm\t\x1a\a\x00\x00q\x0e\x00\x00&\x00\x00\x00org/gradle/wrapper/PathAssembler.class\x95V\xf9{\x1bW\x15=cK\x1ae<I\x1c\xc5Y\xec:A\xb1\x93F\x96l\xabi\x13(N\x1d\xb0-%V\xb1l\xd7[\xea\x04ꎭ\x89<\xa94\xe3Β\xd8P(]\x02e\xa7e\r\x04(\xfb\x0e.\xb4R\x88i\x03\xfc\xc8\x1f\x05\xdc;3\x96duL\xdbO\x9f\xde6\xf7\xdd{\u07b9\xe7ݙ\x7f\xff\xe7\xde;\x00\xce\xe0_\x12\x8e\xa2\xb8\ag\xb1*A\xc3u\x89F\xcfpS\xe2\xa6,B\xe7e\x83'k\"\x9e\x95 \xa1\xc8+&\x8f\xac(l\xee\x1d\t\"n\xf0\xf2Mn\xd6ElH8\x88OK\xf8\f?;\x8b\xe7\xa2\xf8\xac\x88\xcfIx\x1e\x9f\x8f\xe2\x05\t/\xe2%\x9e\xbc̣[\"\xbe\xc0\xfd\x17%\x1c\xc7+\xdc|IėE|%\x8a\xafJ\xe8\xc5ע\xf8:\x1b\x7f\x83\x9bo\xb2\xcfWy\xf4Z\x14\xdf\xe2\xfe\xdbQ|'\x8a\xefJ\xf8\x1e\xbe\xcf8nG\xf1\x83(~\xc8;o\x8b\xb8#\xe0\xc0\x84\xb1\xa2\x942\x9ae\x9bڲck\x86.@\xce\xe9\xbaj\x8e\x95\x14\xcbR-\x01G.͌d&\xb2K\xf3\xb3ٙ\xa5\xf1\xa9|vivn&7yI@l\xe2\xbarCI\x97\x14\xbd\x98\x9e%\az\U0007c03dc\x86nيn/(%G\x15\xb0ozf\xea\xf1\xec\xd8\\m\u05fe\xa2\xa9\x14J꼥\x9a\xe3F\x99-<7\x9a\x91\xbe\xa8\x95Tr\x11yL\xd35\xfb\x82\x80\xd6D߂\x80ИQ \xb3\xfd\x13\x9a\xaeN:\xe5e՜S\x96K*\xc7g\xf0\v\x8a\xa9\xf1\xdc_\f٫\x1a\xa1\xee\x990\xccb\xda\v\x95\xbei*kk\xaa\x99\x9eV\xec\xd5\x11:U\x99,M\nt \xb134G\xdb_T\xed\x9d|,%\x82|]\xf6z:\xed5\xad\xe8\x98\n۞\xef{Ϩ'\xdfE8s\xb6\xd2\xe8E@\xa0\x9b\xc0\x80\x02\xa2ˊ\xa5N*Ld\xb4@n\xbda\x9bi\x18t\fӛ\x89\xfc\x80f\xfe芶&`*\xf1\xee\xec}\x80s\x06d^t\xccҸb\xad\n\x90\x88\xc3|\xe1\x9c7y0 P\xe0\xfe\xbdeղ\x94\xa2\x9aъ\xaae\v8\xe6\x19Y\xea\x8acj\xf6F:\xdf\xf8\x98\xec\xc3\xcb\x1b6\v\xb4\xe5\xea\xa8\x00\x81\x8ey\xa8\xc1kv}E]\xf3)\x8aXn\bʭ\xa9\x96\x8d\x1bjv\xddVu\xcbe:\xa4\xbb\x04\tD\x88\x90#\xda\xfc\xe4{\xb4\xf5\xf8\xc8u\xd5N\xcf\xcf\xe4v953:o\x96j:\u07b6&\xe7k\x94y\x8f\x8dQJ\x92\x9b\x80\x9e\xdd٨_\x80\x10\xe7\x946\xce\x1a\x8e\xb9\xa2\xf2\"\x89}\x87\x8c\x06y\x87\x8ca\\\x90q\x1e\x8f\x89\xf8\x91\x8c,~,\xe3SxJ\xc6\"\x9e\x90\xf1\tL\xd2]n\xf4,\xe3'x]\xc62V\xe8V7\xa3\x18u\xb4RA5e\xfc\x94m~\x86\x9f\x13#i\x19\xbf\xe0\xd90~)\xe3W<\xfa5^\x17\xf0\xf0\a\x179\xfb\xf8\x8d\x88\xdfʘ\xc6\x13\x94\xbaSV\xfa\x14\xa5\xae\xbd\x8ebj\xf9\xba\xbab\x8b\xf8\x9d\x8c\xdf\xe3\x0ft\xf7\xf3\x99s\"\xfe(\xe3O\xf8\xb3\x8cM\xbc!\xe3/\xf8\xab\x80\x0ewK\x99¥G\xb5bN\xb7\xd5\"\xa3~\x93\r\x86\xf1\x16#\xae\b8\x18\xa0\x04\x01]\xf5\xd5\x19G\xb7\xb5\xb2\xda\xf0\xb0{\xccpJ\x85\xb8n\xd8\xf1U\x12n\\\xd3\xd7\x1c;\xeeIg\x90}W\x89\x11\x1a\xdc\xc5\xdfd\xdcÖ\x8c\xbf3#\xf78\\{s\x8d\x94\xf16\xde!q\xf8ŏ\xee\xa6C\xf5n\xb0\xa0\x99\"\xee\xcb\xf8\a'h\x18\xff$m\xb22\x86\xe2$\xbd\xb8f\xc5\x1d\xfd\x19ݸI`\xe2\xefE\xb1\x80\xd3\xef\xf3\xb2\x12\xbaD_\xb34\x0f6U\xb9QWp\x1d\x89@\x897\x1bO\xbb\xb2\x8ep(\xbd `\xe0}]p__\\\xb1lcֿ\x90\xbdM\x058\xc0тw\x81\xa8fy\x18\xfd\x89\x87a$\xb04\xef,\xf3A\x11\x1a\v\xbe\xdcH\xcd\x0eIn\xa3\x8c\\3L\x12\x9c\x80\x8f\x06\x9c\xf4\xeaD\xb3\x86\x83\xeb\xc4\x03\xff\xa7\x98y\x85'\xe7\xbe7W\xe8\x8cgvgt\xd7j\x18\xe5*\xe3\x15\xc4P\xa2\x8fKb\xc4Y+(6\xb9\v'\xae\x8e\xf2I#\x05?Z$\x91\xf3V\x0e%r\x81`\x93A/\x87\xfa\xcaܪi\xdc\xe4\u05edK`\x1b})\x10\xf8\x82\xba>u\x8d]\x06`'^\xf7X\xce\xf2v\x1d>\x9c\xc8\x05\xc7\x15\xe9\x10\xbe\xba\xd4g\x1d\xa5d5\xf9\xdb&\xf8\xca\xce<mX\xb6Z\xf6H\x9c6\r\x92\x80\xbd\xb1\v\x90\x05\x9c\xa0O\xae\xa3\xf4y\u05caN|\x04\x8f\x82\xb2J\xb3\x16\xa4h>\xd40\x1f\xa0?UV\x1aGi\x8d\n-\xb5\x1f\xa3\xd9#\xd4\vԇ\x93U\bo\xb8\xa6\x1f\xa7V\xa2\x1e\x88#D\x01Fh${F\x18\xc5\x18\xf5\xec \xe3;\xb8H\x96l+\xb1\x83d\xea-\xb4Խ\xec#X\xa0\x8f\xb3\x10Nb\x0fN\xb9\x9e\x0e{־'\x1e1,\x06\x91%_\x9e\xcf\xffR$\x91\xfa[\xc9T\x05\xadU\x84\xf2\xc9\xfe*\u0093ɁT\x15\x91\xa1\xd0\x16\xc4E~\x14\xadb\xcf\x16\xa4\xc5*\xdah&W\xb07\xb6\x8f\x9a\xce\x105\x15쯢}(\xbcm{`\x87ml\x87\xad;\xea\xafo\x8al\xe1\xe0b\xb23\xdc\x19\xa9\xa2c\xb3v\x9c.D\xa8M\xd1Q\xfa\xb1\x9f\x18=\x82A:D\x1a\xebx\xc8=ڸ\a\xbav\xb4[\xb8Dk\xa4\x15<\x87\x1c墅v\xdd\xc0\xe34j\xa5\xbd:\xbd\xd2\x1e%j\xc6\xc8~\x82(\b\x93\x9f\xbd\xc8\xd3(B\x96\xf4\xba\xf3ɸNOB\xd4\x1fO\x12\xc2\xd6\n\x0eUqx2v$\xfc6\x8e.\xb6\xa6f\x17C\x03\xb3wѹٔ\xba\xb3\x94\xb2s.\xaa\xa4\xb7\xbb\x86긋@pG\x8c\xaf\x85,c\x98rQ\xb5\xf0\xfb̏{\x87\xf6\xf1\xce\xc1X\xd7]<\x90'Һ'\xfb\a*8\xb6\x85\xe3\x8b!\xc2\xf2\xa1*\xe2\xed\xf1\nNl\xe6\xb7л\x18;I9:u\xdf\xf5|\x02=\xf4kdm\x88\xda\xf3h\xa3ӵ\x93xzH\x7f\xbd\x14e\xc4E\x1a\xa6gݘ\xc1,\xedh#f\xe60OXzi\xbc\x80\xcb\xeei\x06k\xe8\a\U000642fe\x85\xbf\n|\xa4O\x915\v\xad#\x15{\xb0\x82\xd3\xc3\xddw\x10Nm\xa6Z\xbb+H\xd4y\x89\xb9\xa7\xc9P\x8e\xb2\xe4\xf9\"q=\xeeƏ{{k\x11:pō \x92\xc5U|ҕ7}\x86\xf8\xb1L\xb2f?]\xc4G_\xbe\x9f\x95s\xfa\xc21\nؿ\xd9\x7f,\xf4t\x05\xc9z\xc4\xc3t2PnÔU\x99\xf2\xd9N,\xc7\bu=+]\xb5\xa8]X\xc2\xd3\x14#\x8cCP\\\xad\xc8t}8>g\x85\xbeq\xfc\xf8\xaf\xd0>f$\x93\x8a\x11\x82\xfeۈ&\xdfD\xcbf*6\xe0\xce\xdaY\xee\xb1\xc1\xbbHW\xf1\xd0&\xa7\xc5\xd7|\xec\f\xc9;\xc5Z\x7fx[\xe7\x8f\xdcoB:OJ]\xa0\xa8\x97)\aOҕ\xbd\xd2pa35\xa4\x19\x14\xfc\f\xa8\xee\xfek\xf8\xb0\xab:z\xb1R.\xcfA\xf8\x1fPK\x03\x04\n\x00\x00\b\b\x00\v;\x84B\xe0\xb7(/\xf2\x0e\x00\x00\x9d \x00\x00 \x00\x00\x00org/gradle/wrapper/Install.class\x9dX\t|\x14\xe7u\x7fO{\xccj5\bt!-\xe6X\xb0\x04\xba%n,0\xb6.\x8c\x12IȈ\xc3\v\x01<Ҏ\xc4\xe0ݝ\xf5\xec,HĎ\x13\xc7\t\xbe\x92\xb44m\x82\xd36\xa9\xebTu\xeb6\xc11\x92m\xc5\xd8MZ7M\xdb4m\xd2#i\xeb\xb4q\xd2\xe3צ\xf7\x9d6\xf4\xfffV{H\x8b1F?\xbe\xef\x9b\xefx\xe7\xff\xbd\xf7}\xfb\xd5\x1f\xbd|\x95\x886\xf3ǂ\x14\xa5?\x91\xe6[\xd2|[\x9a?-E\xf3g2\xfa\xf3 \xd5\xd1\x1b\n}\xc7C\xfc\xcdׂ\x14\xa0\xbf\x90\xb5\xbf\x94\xe6\xbbAz\x93\xbe\x17\xa4\xef\xd3_\x05\xe9A\xfaki\xfeF\xa1\xbf\rR\x05\xfd\x9dl\xfd{\x85~PF\xff@\xff\xa8\xd0?\x05\xe8\x9f\x15\xfa\x97 5ҿ\x06i%\xfd\x9b\xcc\xff{\x80\xfeC\xfa\xffT迂\xb4\x96\xde\bR5\xfd\xb74\xff#\xcd\x0fe\xed\x7fE\x8a\xff\vЏd\xe6\x9a¤0\a\xb8$\xc0\x1eL\xb07H\x9b\xd8'\x8d?\xc8\n\aJ\xb9\x94\x83\n\x97\x05\xa9\x93\xde\b\xb0\x8a\x9e\x97\xc9\xc6r\x19-\x0f\xf2\n\xaeP\xb8R\xe1*\xd9^\x1d\xa4]\\\x13\xa4\x9d\xbcR\xe1\xda \xed\x11\tvr]\x80C\xf8\xe0U\xd2,W\xf8\x96 \xf5\xf0j\x85\xd7\x04\xa9\x8fW\axm\x80\xd7\x058\x1c\x04\xaf\xf5\xa5\xbc\x81o\x85V\\\x1f\xe0\x06\xe97\n\xb7M\xd24*\xdc\x14\xa4\x11n\x96\xa6\xa5\x8c[\xb9M\x9av\x85;\x82t\x98~\x88\x86;e\xe3f\x85\xb7(\xbc5H\xc7do\x84\xb7Ɂ\xedЛw\x04y'\xef\x92\xcf]A\xbe\x8d\xbb\xe4s\xb7\x8cv)\xbc\x87)\xd4\u05ff\xaf\xfb\xf0\xe0\xa1S}\x03\xa3\x87\x0e\x0e\xf4\x1c>4p`\xf8\xd4H\xf7\xa1\xfdL\x95\x83g\xb4\xb3ZGLKLv\x8cږ\x91\x98\xdcʹ\xac\xd7L\xa4l-a\x1f\xd1bi=\xc0\xb73\x05\xa2\xe6\xb9D\xccԢLk\aMk\xb2c\xd2Ң1\xbd㜥%\x93\xba\xd51ЗY\x97\xe3I\xcd>ݝJ\xe9\xf1\xb1\x98n1m(v`$\x7f\x0f\x0e\xadҧ\xc6c\xe9\x94qV\xdfg\xc4\xf4\xee\xf1q=\x95\x1a\xd2\x12ڤP\xe8(F\xa1\xff\xba\a@ο\xc7H\x18\xf6^\xa6\xdeƷ\x16\xf7Ʋ5\x1da\xf2\xf6\x9aQ\x9di\xf9\xa0\x91Ї\xd3\xf11\xdd:\xa4aQ\xecg\x8ek\xb1#\x9ae\xc8wf\xd2k\x9f6RL\xab\x8br\x16\xcb\xc6b\x1018n隭\xf7\x19)\x9b鶢b\x1eu{\xb8c\u0098L[\x9am\x98\x89\xddM\xae\xcb\f\xb3C\x14\x17{\x8f\xe7\xaf35\xbdmR\xd0(\n\xf6\x961\x96\x96\xef\xc3V\x8c\xa9\xdc%\x9f\xd0\xed\x8e\xc3\a\a\xb0\xa5\"&*\xf6\xe5\xedS\x18\x86\xad\x18\\<ͤ\x0e$\x12\xe0\x11\xd3`<\x18`\xdb\r\x8d[\xbf\x84\b\x18*\"S\x9faeeɩ\xaa:\xb2\x1c3\x92\xf2\r\x13\x02\x03zR\x8e\xa5\x14\xbe\x83\xa9jR\xb7\xf3\x89\x1d4Mض\xb3\xb1\x90\xccR\xc8/1im\xbeY\xfa\xf4Ըe$]\x15\xbdQÂj+\xdc\x13X\x8fu\fb/ά,\x84\xc2tr\x01\x0e\xe1E{\xf7\x14rۋ\xb3\x81\x98\xab2(\x87\x17I۴\x94\x93w\xc2\xd1ޫYV=\\x|\xb1\xf4ޘ\x9e\xc0\x02\x0f0\x95\x18\x18\x94\x8e\x1a\x93\t\xcdN[\xfaRc,&_D\xb8\xba\x94n\xf7O\xe9\xe3i[\xf4\x19ѭ\xb8\x91J\x89\xcd\x01\x82\xc5Ԏ\bK,\xd4e\xe7{\xd2\x13\x13\xba\xa5G\x0f\xeaZ\xd4\r͔\x1d5\xd3\xf0KM\x1e\xeb}\xa6\x15\xd7l\xdb\xd9\xe0\x8d!\xca@'9\x86\xb4\x90\xe7\xac\x11˔ \xefI\x1b1\x97\x12'\xe1\xf2\xa5\x1bdEϒ\x87\b\x03\a\xb28ٝu\x87sb \x01\x96V:i\xeb\xd1\xfc-A\x17\xb3\xfb\xcd8\xe8,s?z\xcdx\\K \xff\xa98aZC`\x84\\\x03\xe3\x1a\xa9\xa3F\x02\xe9\x11Z{\x1a\x9b\x8eAA35\xac\xc9\xc9Ҩ\x1e\xd3%\u00ad\"\x96\xc2F%\x95v\xd2\x16\xe4\xc5\x17\x1b\xc0\xc1\xf8i(g\xe9\x00Z\xd5\xf1b\xa9\xd9\x13\x15b\xbet\xe2\xbc\x01\xe5\xd7\x15Ev\xbe3|z¶\xa6\xb3\xfepl\x8d\xa3\x1d\b\xa1~Y\x91\x98\x823\x92i\x1b,t-.0^ r o^X;,\xbdQ]\xf2\x95\"t\r\t\xf2\xda<\xca\xfd\x89t\\\xcf\xe6\x16\xe5\xfcB\x9c\xd6.垁\xea\xf2q39=\x90\xc8\xe3ߞ\xd3)o~wq\xa9\\\xbc%\n\x9c\x9d(\x10\xdaA\x9a\x7f\xcc\x01!\xf6\x1e\xef\xc1\\L\xec\x1b\xd4\x1c\xdb\xd7wvv2\r\x16\xaf\x12\x99\\}\xd3\xe9\xa3d\xaaS\x9a\xcd\xd2lɱ\xda,\xac\xf6\xbc%\xab\xa2\xb9;\xbf\xb8.\xd0\xdarcZ7\xcc#\v\xb4\xb6\n\xad\xb6\x9b\xa1u,wx\x9b\x1c\xde}\xd3\xf6\xcb\a\xe9\x02\xa9\xed7-\x87\x1c\x1e5\xd3ָ\xee\x02M\xcd\xeck\x97m*}\x82>\xa9\xd2\x17\xe8\x05\x95~\x99\x9eS\xe9#\xf4Q\x95\f:\xa3҇\xe9\x82J&\xdf\tv7u\xb3\x90C\xdd*\xc5)\xa1\xd2}\x14S\xb8G\xe5^\xeeS\xb8_\xfa}*\xdf\xc5\xfbU\xe4]ܬV__\x8bz\x00\x83\x84ԻT~7\x0fB\xec|\xad\x14\x1eRy\x98\x9eE\xf2\xcb!\xed`:a\x1bq=\x9b\xa4\x98\xee\xbdˡ\x1dίT\xe1M\r\xa9Mᨩ\xa7\xc2\t\xd3\x0e\xe3^`kF\"\xac%\xa6\xb1\xcd\xd2\xc7mSb\xb6=\xdc?\x95ć\x1e\r\xdbfx\x02\xc9+\xacOi\xe3vl:\xbc9\xbbo\xba\x1dE.\xc7\xff\xc0\xd8\x19L\xab|\x80GD\xf0\xbbU>ȣL'\xae+D\x86w\n,\xccp\xfc\x9dI\xa0\xf2!>\x8cL\x98\x03n\xb7eiӂ^\x95\x8fг*\x1f\xe5{T\x8e\xc8\xe8\x18\x1fW\xe9\x17\xc5j\xc11#\x911\xbc\x88\xfa\x1e$\xbf\xebՐ\x02\x15\xdd`F\xca\x1c?\x1d7\x91\xe7=;\xb7oW\xf9\x04\x9f\x14*\xa7T\xbe\x975\x85\xc7T\x1e\xe7Q\x85\xa3*}Y\x8aL\xed\xe2\xf3Y\xca\xe1Q݆V\ve3\x9c\xcc\xd5\xcd\xf0\x84iu\x85U\x9e\xe0I\x95O\x83\x01R\xffI\x85Ϩ|\x1fߍ\f\\\xbct\xe2\"_$ǹk*\xc78.r&\xa41\vl\x96-\xad*\xea\xe5Id\xf0\x86TCB<y\xff¶\xc2\x12\xa9\xb2%\xdb־u\x95dj\xee5ӱ\xa8\x03\xb4\xd4\rT\xc5\xfdv$\xa6k)\x00\xc5\f\xcb\xe5X\x10\x91F$L\x87\x8d\x89\xf0\xb4\x99\x0e\x9f\xc3kC\xb0\x90\xc6\x1e\xfb\xb4\x1e\xce\xe0\xea\xf0\x00`\xa8\x98\xa9\xf6\x04\n\xaa\xca)\xb6\x15N\xab|\x96ϩ<Ũk\xca9\xb7\xf6\xaa|\x9e߫\xf2\x03\xfc\xa0\xca\xef\x13\x14\xd4\x14\xad8\xb0\x8e\xa4\x84y~H\xe1\xf7\xab\xfc\x01A\xce\xc3\xfc\xc1\x05\x9b/)\x8f*?\"\xee\xf9\x90P\\\xbd\xd8-\a\n\xeaf]~\f\xe7/\x89G>,\x0eB\xcay\x95^S\xf8Q\x95\x1f\xe3;\x15~\\\xe5'\xf8I\x95?\xc2\x1fE\xac_?]\xe0\n\xb20#q\x86\x9a\xbb\xe5\xe6\xef\xd5\v\xbev\x1c\x9a\xe7E\\Y\xe4\x9a\xd080 \xfd\xa6\xb7\xf9r@\xe1^t\xd5F(56-~:\x84o$'ө\x9by\xf8\xbc\xa3\xe7D\xe5\"I\x9d\xebXV\xd8\\\xd1\x0eb_\xf6e1\xf0\x8e\vZ\xa1\rĨ~-s\xcb\xeb.z]s@\x87t9\x9e\xb6p\xf1\xb3;z\xc1A\xc2h\xa1b\xe7\xe5_HY^X\xc5\x11\x01F\xaa?\x9e\xb4\x11\v\xfe\t'ҝ\xc7\xe4\x92;\xca\xf1\xa5Ě\x8a]0k\x8a\x9cu^\xc0)\xe3\xbc\xee\xc0\x05\xaf\n\x0fL%[\a\x8a\x8a\xe8ק\\\x98\x96ʫF4u^\nMK\xde)eF\xaao!ك\xa8\x16\x8d.\xe2\xbf (\xae\x1b\xb7\xde\xf8\x11\a)W@\xb0^-a&\f\xe0@\xa0\xc1T\xddXTϕ\x8dE\xee\xd7N(\xc0Ŗ\xa3]\xfe\xb9\xdcӢbɤd!Ͱ\x91g\v\xeb\xc9t\xca\xd6\xe3\xf9W\xd3\x110\xc9]M\xfd\x82)yP\xb4\x153\xf9\x92\xa9ܳGB\xaf{,e\xc6Ҷ\xee\xea\x18\xb0ͅ\xeaUU\x84\x19\x04L\xcaW\fQZ\x8e\xc3\x05\xf7\xedڼH(\xbc;\xd7\x15\xbf\x89\x8b\x91\xaarK\x99w\x9d\xcc\x06\xb0\x1e\x1dt\x1eo{n\n\x82K\x9e\x80\x12\x8b\xd9\x17V\x19>`j\x04\xa0\xa0|\xe3۲\xd6\xee\x05W\xb8\x91\"iA\x9e\x94\x87G\xb3?y\xe5-\b\x14ms\xd0<\x87\x8c\x83\"\x95㑿\xa7(\x0f\x05\xd5G\x9f:0q\x9d\xb8\x19p\x1e\xb3)\x17ME\xdfs~\xf7\x89\x88\xdaޘo\x8b\u0087TM\xd1\x05\x00ᴖ\x1a2-\xbd?\xa6Ǒ9\x00Ĳ\x84>eg>\x17C?\x1b\x9f\n\xec\xe9>P\xfd\xf1\xfbܟ3B9\x7f.y_u\xe4\x1b\xa3\xe0\xedx=\xe0T\x17\xa3%\x17\xab\x98)֭*r\n\x86\x12\xf0H%:\xde#v\xf3\x9d\xb3\f\xb1\x8b\x82o\xa9M\xb4\x9e\xa2TGD^\n\x91N\x13\xc44\x89\xaf\x12:M\x95r\xa7ǸR\xae\xe4N\x8f\xfb9\xfa\xe5\xd8cR\x12\xed\xfd\xf8\x8a\x93\x8f<\xe8\xd75ϑ\xd2<O\x81Hei\x05}e\x8e\x82W\xa8\xac\xb9\xe5\n\xf9\x9a[\xaf\x90z\xd9!j\xa1]\x89\x03D\xb7\x82\xe1z\xaa\xa6z\xb0m\xa0\xb5\xb4\x91R\x98\r\xbb\xa4Ȧ4\x913\x12\x11\xd8\x19\x89\x10%\x18\x9f\xa5s\xe8\x85\xf5g(@~\xf4\xdbZfi\xd9P\xf3\v\xa4bP>\xdc6K˻\xbchWt\xf90Y\x16\xf2\xcdSE\xa49\xe4\vy[\xe7\xa8r\x96\xaa^\xa3\xea\xcf/\x92\xa7\tm\v\x95S+dj\xa3U\xd4\xe1ȳץ\x9f\x95g\x1bM\xd14d\xf0a\xfd<\xbd\x17\x14\xca!\xf9\x03\xf4~\xc8]\r\xe9?@\x0fC\xafU\xf8\xfb F>z\xc41,Ӈ\xb0\x13o\xa3\x8cɾH\nf\x89\x86\x9b[\xe6\xc8;\xdc\xf6\x12\xd50]\xa2\xbayZ\x19\xa9\xac\xf5\xbeBu\x11O\xeb\xe8\x8b\x14\x9a\xa3U\xafb\xf5\x16x\xf1ә\xe5Ջ\x97=/њ\x12\xcah\xe4q4Z\xe5H}\x10\xed(\x9cu\b6>B\xedt\x94z)\xe2h\xd5\xecr\xcfj5\xec\xc8\xcd\xce\xe8Q@\xa0\x04'\xf7\xd3c\xf48\xe8=A\xf2\xa4\xe2\xcc̓\x98)\x91\xf7^\xc6\x03\xbf\x8f^A\xdf=Ok#s\xb4n\b\x0e\b_\xa2\xad\xe8\xd6\x0f\xb7]\xdd\xe1\xf5\xec\xf0\xd5\xf8j\xbcOS\xb8\xadƷ\xa5\xcb\x1f\xf2\xcf҆K\xa4\xb6\x86\xfc/ѭ%t\xf4\x11\x1f\xcf\\\xfbNkN\xf8\xb5\x0e\xc1\x13p\xedI\b\x7f\x8a\x1a\xe9^\xea$\x8dn\xc3\xf8\x0e T\x14؏=\x8dTA\x1f\x83\xd8~\x18\xbe\x85~\x8c~\x1c\xe7\xebh\x13]\xa4\x9f\x80z\xb7@\xe9\x8fc$\xae\xed\xce*ڝQ4\x00j\xa2^IV=w\xe6I\xcc\xfc\xa4\x03\x8c\x9f\xc2\x7f\xbcr]\x87\xf1\xb7\xc0\xa5\x14\xf3o\x02\xe3\xf5\x97\xc8{y\x9e\xaa#-\x95\rs\xb4q\x88\x87\xe7iS\xc4\xff\n5F<\x95M\xa3\x11oe\xf3h\xc4\xd7:K-\xa3s\xd4\xda\xe5\ryg\xa9\xad\xcb\x17\xf2\xcdR\xfbS\xb4\xe1y꘧N\xd8js\xe5\x96Yڊ}\xdb\xd0\xcd\xd2\xf6Y\xda1C\xfb\xe6igd\x9evEd\xfbms\xd45G\xbb\xbb\xfc\xf3\xb4\a\an\xefR\xc4z{#]\x81ש6\xa4T\xde\xe1B!\x14\x18\x9d\xa5;\x8f\xce\\\xfb^H\x99\xa5\xee\xe1\x19Z\xe9r\xedŰ\xcc\x1d\xf6\x0f\xb7\xbdN\x9b\xf2x\xef[\xcc\x1bk\x95w\xa1\xbf\f\xc5k\xe8\x19\x9a\xa1\x1e\xa7\x7f\x9e\xfa2qr\x92j\xd1\x1a0\xfd\x19\x98+FU\b\xfd\x1a$\x82\xadH\x05]0S\x0fvi0\xf5Y\x18\xfb!\xc4\xe8㈕\x9fE\x94<\x83\xe8\x98A\x8c<K\x0f\xd2\xe70~\x9e\xdeG/`\xc7\xcbp\xc6U\xb8\xe3ۈ\x95\xef\"BıI*\xc3\xf9v\x04\xc3S0\xf9C\xc8\f\x9f\xa2\x9f\x06\xc7\v\xb4\x86~\x06\xc8\f\x80\xdb\xfd\xa0\xfai\xb8\xb8\vr|\x86~\x0e.~\x16{\x9f\xa6\x9f\xc7\xdc\v\xce\xe8\x19\a\xdfof\xdd\xfe&}\xd6q{\x15}\x9d~\x01#\xd1\xf0k\x90i\xc2A3\x1e\xb7\x99\xb8<\x86\xaf\x12\x89\x9f\xca\xfd/\xd2\xc0\xf3\xf4\xaeYz\xf7`K\xe5\xe0,\r\x95<C>\xefs\x9e\xe7\xb2Y\xa3\xd2\xe1\xf1(\xc0\xf3\x182\xc8\xe3\x80ޓ\x8e\x06+]\n\x19\xde*\xf2\xc3/9I\xb5D~*\xc9\xf0\xf9,\xa4\x14`\xeelq\x82\xa1\x1d\xdd\xf0\x90g\xef\x9a֫O\xd3\xfaf\a[\xadk\xb6\x00\\s\xe4\xd9\xe1\xad\xf1>E>\xcfs\x8fx$P\xb0\xf5@N\x88\r0\b\x01\xfb\nЯ\x02\xf9\xd5@|\x03p\xdf\f\x14\xb7\xe2{\v̗rҖ\x0f\xf3\n\xfd\n\xfd*\xc4.Gl|\x0e;=8\xb3\x91>O\x97\x1d\x91wf͵\x13.z\xd8\x11\xf9\v0\xa8\x13\xe8|\x0f8I\x04\\\x9c\xa7\x91\b\xb2\xd6\xdd.\xae\x0eJ\xf2\x1a\x95\xe4e`p\x88\x91\x87\x0e\xbbX?r\x89jE\x93V\xf98\n]f\xe9\x1e`\xf4\x1b\xf3\x14\x01\xc0\x8fE\n\xd6\xe6\xe8\xf8\x1c\xbd\xa7\xcb\xdf\x1c\xf2\xcaԉ\x90\x7f\x8eN\n\xd8O\xcd\xd02\x17\xf6\xa7Bʫ3\xd7>.L\xef\x95ɀ3\n\x05^\xbd\f\x8dN\x00\nb\x0f\v:H\x1f\x04t.\xa0\xbf\x00\xe7\\\xc8Zk\x1f2\x86\x14\x8d \xa0\xb2\x02N\b\x01\f\xf5p\x7f+\x00p;\xdct'\xecs\x02\x969\x03\x8b\x98\xb0\x81\x85\xfe\x01\xd8\xe0!\xba\x82D>\vJW`\xe7\xb9l\x06\xaa\xa7{03\v۞@y\x9a\xa3\x17\x9d\xb4{1kǋ\xf4R&\xad^\x04\xd8\x05v+\xd0\xce#\xfd{ ã\xf4\n\xe0\xef͖\x88\x1e\xac\xe3\x19\x9bI\xacO`N \xd2P\xe1\xa5/\x06\x86[Pʹ\xc8\x0e裡\xbc\xb5\xcdS\x03\xd5\xc7f\xae\xfd\x00`\x18G\x10\x9f\xca\xd5V7\xf3\xbf\x8c\xf6\x15({\x95Vӯ\xa1*}\t\xa2~9\v\x04\x10͊\u0600\xf5/9\"6`ǋN\xe6_G\xbfN\xbf\x01\x11\xcb\x11\xef\xaf;\xc94+\xe2\x8a\x00\xfd&}%\x83\xe2~\xec\x91j\xad4\xb7\xa0\xac\xfas\x05\xd5\xef\x10\\\x93W\xd0\x15\xfa\xad\fC\x85\xbe\x9a\xb1\x89B\xbf-\xa5\x06$\x7f\x87~7C\xb2\x03\xbd\xacI\xd9\xf6\x15'\xa8\xba\x1b\\\x828\xfc5\xfa\xbd\xcc\xe1\xaeL\xf4\xfa\x9d\xaaZ\xfc\xb4\x1b\x9d\xfe\xac8~W\x1c\xd0\xf9:jY\x11:y\x11\xff\xf6\xe8\xfc\x01}#\xe3\xc2B\xfb\x94\\.J\xe8\xad\xed#\x98\xc97\xfe7\xe9\x0f\x8b\t\xc9\xc5i_GH\xcc\xfe\x91\xb3\xff\x8f\x81lId%H\xcc\xdfGRf'>\xf0\xef\xff\x01PK\x03\x04\n\x00\x00\b\b\x00\v;\x84BG\xdc\x1a\xc4\xc2\x04\x00\x00\x95\t\x00\x00-\x00\x00\x00org/gradle/wrapper/BootstrapMainStarter.class\x8dVY[\xdbV\x10=²eT%\x80Y\x12\x93@\x9c\x00\xc5f\xb1Ӕ\xd2\x06(MB\xd9\rI0K\x1d\xd2E\xd8\x17#\"KT\x96\ttK\x97\xb4\xfd\r}\xedK_\x9b\x17\xc3W\xbe\xa6\xef\xfd\x17}\xea\xbfh;W\xb2\xc1\x1b\xf9ꇻ\xcc̝9g\xe6ޑ\xff\xfc緗\x00\xde\xc0\x8f2\x02\xb8'\xe3\x1a\xa6$\xbc/aZF\x1bfd\xccbN\xc6<\x16\xf8\xb0(Ã\xb8\x8c%,\xf3\xe1\xbe\x1f\x0f\xb8\xe4\xa1\x1f+\x12\x12\x12\x06d4aU\u009a\x8culH\xf8\xc0\x8f$w\xf3\x88\x0f\x9b|x\xecǇ2>\xc2\xc7\x12>\xf1C\xe5\xcb-\x19W\x90\x92\x90\x16\xe0\x9b\xd0\f͞\x14\xe0\tG\xd6\x05\x88Sf\x9a\th\x8ak\x06[\xceg\xb7\x98\xb5\xaan\xe9$\t\xc4͔\xaa\xaf\xab\x96\xc6\xf7E\xa1h\xefh9\x01\x91\xb8ieb\x19KM\xeb,\xf6\xd4R\xf7\xf6\x98\x15\xbbg\x9avΦ͒\xaa\x19\t[\xb5lf\x8d\v\xf0\xe6\xf8R@ox3\xbe\xab\xee\xab1]52\xb1\x84miFfܕhflF\xd3ٸ\x83G\xb52\x14\xa1\xb5\x8e\xb1\x00\xd9\r9gf\t\xcb\xc5\xca\xc3\x02\x1a]\xed\x82j\x11\xfa\x94i\xd8\xec\xc0\x9e\xd2\xd5\\.n\xaaiF\u00a0{\xc2`vlm%^\xa6⇳\x84\xda\x11\th)\x8b\xed\x88xh\xae_b\xf6\x8eI9\xec,3\xb0ض\xceRv\xccՑeGe\xe2\x0e\xf7J\xc9k\xabv;10\xc9=O\x1f\xa4؞\xad\x99FN\x02/Ŷf\xa4\xe3j\xdeH\xed0\xcb!\xd3\x1d\xae\xcaS5sq[s\xaa\xa3ZV/9ج\xd1\xeb\xcc \x850/\xa0A\xa3\x85\x9c0\xf3V\x8a\xcd8\xa7\x82\xf5\n\x17\xe5\x1e\x14\x84p]\xc1;\xb8-\xe0\xd29\xa9\x13\xa0\x94k\x14l##aG\x81\x86]\tO\x14\xe8\xc8*0\xf8\x10\x82)aO\xc1\xa7\xb0\x14\xe4@w\xa2\x9b\xaeQԭZT/2\x8e\xce:{\x8eEA\x1e\xfb\x84\x9e\xa7\x9ehUeO\xc1S\x1c\bh>\x13\xdf\xdfڥJH8T\xf0\x19>/!+\xa6\x81.\xbc\xaemq\x14_(\xf8\x12_)x\x86\xaf\x89\x97\x1b~\xb8\x14~8:\xf0\x98\xc8[\x12\xbeQ\xf0-\xbe\xa3b\x9f\x05X\xc9\x1b\xb6\x96e\xa7\x05\x130=e\xe6\xf5t\xc80\xed\x90Ne\xb7Y\xc8\xdea!\x97B\xa8\xe43\xb4pw%\xa4\x19%qZ\xa3lk[y\xee!\xd4ߗ\xeb\x8f*x\x8e\xef9\xb4\x1f\x04\xf4\xffϧEo\xe4\fX\x19\"\xafm\xae\xadP\xa1\x9bÑ\xf2\xeb>?^Y\xa9\xf9\xa2e\xbc\xc62N\x96\xedU\xb9.պ-\xc3\xec\xc4a\xcef\xd9ʇU\xf2P}\xc2y\x95\xcc~\xa0Z̠zGJ=\xa0\x14\xe9\x9cS\xbc\x11\x94\xd5uu\xc7b*=\xbb\v\xa9\xbc\xc5\x1d\x95\xf6m\x15a])\a\x9fc\xf6T\x9d\xe7\xdf\x19~E\xb8F\x9d\xd6\xc5\xf7\xdf\x17\xaem>5\x04\x8b\xd4J-\xe1N\x9d3\x9b5g\"\xafj\x1c\x97\xcf\xd3Q\xd3\u058c}\xf3\t\xdd\xe1\xdb\xe5a\xdc\xfb^\x11\xa6(\x8aԊ\x04\xf4T\xb5\x91:$\x9dD\xd0\xf5\xe4z\xde\bÑ\x9a^\"\x11\xe9e\x957\xe0\xca\xf4\x9f6\xe9\xe6j\x19\x9dɪ6=\x04\xf2\xd8^/\xb5\x8f\x88\xe0\xb6i\x91Q\x15\xc1:y\xacC\xf04r]\xe7\xeb\xb8N\xdf\xdb\x00\xf8\xcf\x03\x81\xb74\x1ao\xd0.F\xb3@\xb3w\xe0\b\xc2\vZ4\xa0\x87F\x9f#\xecD/\x8d\x8ak\x80>\xbcN3=N\x84Ɋ\x1f\xfe\x9b\xec$\x9a7\x06\x86\x8eа|\x02OR\xfc\x1db\xd23\\\x80\xb7\x00_\xe2\x18R\x01\xfe#4\x8e\x89ǐ\x83b\x01\xaf\x05ŀR\xc0\x851o\xd0\x1b\xb8H\xd6MIO+\x9a\x13\x05\xb4\x8c\xf9\x82>\x81$\x81\xa4g\x90\xf6\xad\x1b/\x1c\xb4\x1cN\xb7\x13\xe6*\x85\xeb\xa2}7\x06\x89\xcd\b\xb1\x98%\x1e\xeb\x04\x85Üs\xa1\x14a\xf2U\x04\x03\x0e\xf4\r\xb2\x1f\"\xc8><\xc40\xad\xb8\xcf\x11D\x89\xbbHs\x0fnҿ\x12/\xf9j\xc6-\xbcIV#EҮ\xee-ҍ\x92D$\xc9\xdb䅾\x04\xc5\xdc\x1dQD\x1f͋'hK\x0e\x06ڏ\xd0Q\xc0\xa5\xa5\xa1\x97\x93\x9eQ\xb1]\xec\xfa\x19׆\xda\xc5[\x9cj\x01\x97\x03\xc1\x02:\x7f\x82/\xe8\xfd\xf5\xb9(\xfc\xf2\xef_'\xb8\x92\f\\-\x11>F\xd7\x11\xba\xff8\xad@\a\x85\x05\x91\t\x11\x9da\xca\xf9MZ\x8f\x12!Nu\x92\u0086\b\xee\x18\x91\xf1\xe2\"\xd5c\x1c\x13t\xae\x85l\xde%\xad\x87\xfe\x00\xdd\xc0{\xb4\x129\xbcӔ,:\x89\xe0\x05\xbf\xe3Ĺ\xfb\x1fPK\x03\x04\n\x00\x00\b\b\x00\v;\x84Bf[\xdb\xf1A\n\x00\x00\xb5\x16\x00\x00(\x00\x00\x00org/gradle/wrapper/WrapperExecutor.class\x95Wi`\x1ce\x19~&\xd9t7\xdbiJ7I/RXJK\xd2M6ᦴ\xa5\xd0\x1c\xd0\xd4\\d\x93\xc6\x14\xb5Lv\xa7ɔ\xcd\xce2;\xdb\x03E\x14\xb5*(\x88\x15\x15\xf0\x02\x8fz[\x94&@\x04\x14\xb5(\xde\xf7}\x8b\xf7}\xe0\x8d<\xef\xccdwv\xbb\xad\xe5\xc7~\xf3\x1d\xef\xf9\xbc\xc7\xf7\xed\xe3O=\xf80\x80s\x94@\x10\xb7\x04qk\b\xaf\t\xa3\n\xb7\x05\xf1\xda0jp0\f\x05\xaf\x93\x9dۃx}\bo\b\xe2\x8da܁;è\xc5]a,\xc4\xc1 \xde\x14\xc6b\x1c\xac%\xe5\x9bex\x8b\fo\x95\xe1m\xc2}\xb7\f\xf7\b\xc9\xdbCx\x87|\xdf);\xef\x92١\x10\xde-\xdf\xf7\xc8\xf0\xde\x10\xde'\xdf\xf7\xcb\xf0\x81\x10>(\xdf\x0f\xc9p8\x88{C\xf8\xb0\xe8\xfc\x88\xf0\xde\x17F\a\x8e\x88U32\x9b\x95\xd9\xfd!< \x16?\x18Ĝ\xd0<('w\xc9\xec\xa3A<\x14\xc6y8\x18\xc2\xc3\xf2}$\x84\x8f\x85\xf0\xf1\x10\x1e\r\xe1\x13!|2\x84O\xc9\xf6\xd1 \x1e\vc\xb3\x90}Z\xbe\x9f\x91\xe1\xe8B<\x8eϊ\x94\xcf\x05\xf1\xf90z\xf0\x05Q\xf2\xc50\xbe\x84/\x8bi_\t\xe3\xab\xf8Z\x18_\xc77B\xf8\xa6\x82\x15ݽ\x89\x91\xe1\xde\xceё\xde\xc1\x81\x9d\xa3\xc3};\x87\x86\a\x87z\x86G\xc6\x15D\xfavk{\xb4\x8e\xb4\x96\x99\xecHؖ\x91\x99ܨ`Q\x97\x99\xc9\xd9Z\xc6ޮ\xa5\U000fa095%\x02:\xb7$z|\x12\x96\xed\xe8\x1dڙ\x18\x19\x1c\xee)?)e\x1b\xda2\xb2\xb52[\xd9I8k\x99Yݲ\r=\xa7`\xa9k_\xde6\xd2\x1dC\x85}\xdaXW\xa4\xba\xdcH\xd3\xc8:\x97\xd20;d-^\xecլ\f\x1d\x1a\xcc\xdbټ]\x10帺%\x9b\xd53)m\xc2!\\\x9043\xbb\x8cI\x05\xeb\xfaLk\xb2c\xd2\xd2Ri\xbdc\xaf\xa5\x91\xc8\xea\x18s\xbf]\x0eM\xde\xd2l\xc3̐\xa9~\x97iѠ\xddz\xd2\xee6,\x8e\xa6\xb5_\xc1\xb6\x96R+*\xab<\x91\x9e\x9e}z2OaT\x11\xe82Stlq\x9f\x91\xd1\a\xf2\xd3\x13\xba5\"\xec\x1243\xa9\xa5\xb7k\x96!koS`\xf3\xacQ\xb0\x9c\xd6y\x02\x87\xcapZ\xb0\xc9\xc8\x18\xf6f\x05\x97T\xb4\xb5\x1c\xe9\xe3x\xb0]\x81Ba\x8d\xbe\xe3\x9e}I=\xeb\xa1\x13\xb0\xa7\fFo\xcd\xc99\xba4k\xe9Y\xcdһ\x8d\x1cSp\"/BF-C\xc1)-\xeb\\\x05\x19\xdd\xee\x18\x1d\xee\x95`\xe5̼\x95,ƻx\x12.\x18\x90\v\xe2[\x8c\xbf\xa5k)G\xa49j\xa5\x99\x8e%\xebn\x9dJ\x93\x9a\xad\xa7\xc64\x06\xae\x96\x80Mk\xb6\xad[\x05\xa7\x1c$.\x9fߦ\x82\xdaTQX]\xda\xd4RC\xbeDm>)4\x05\xb7\x90\x91a\xa5\xe9\xdatA\x13yz3\xccQw{c\x10\xdff\xd4'uۏ\a\xc1\xe0NI\x16*hmy&)\x1b\xd4\x1dȉ\xddU-W\x1d[\xf7\x95$\xf5J\x1fH\xa7+\x9eu\x9a\xa6M\xfb\xb4l\xbf&\x1ei\x96\xa0$\x0e\x064k\x92\x88\xd4W\xd0A#\fW\xa4\x82\xa6\x13)T\xd00QA\xfeqj\xb4\xa2)\xcc\b\x97l\xab9M\x9f\x17\x12>/\x12\x8c\xf7Y-\xc7\x1a\xb7\xae\x92\xbd\xaa\xd7g\xf6\x0fh\"e}\x05\xbe\x93\x95\x94\xd2wi\xf9\xf4|S\xad\xd9\xe3~\x1b\x99\xfb\xa6e\xf7\x1b\xb9\x1c\xe9\x8a\x16\x86\x13N\xa2\xbbE\xdbPV4\xed\"_\xc1\xea\xff_^\xd4\xeb\xcfK\x05-e\xf4\xee2\xee-ۋmUE\x12ߡ\xeaJ\x89,gߕ!\xa5\xe2{\xf8>\x8b\xab\xe8\xf0p>c\x1b\xd3z\xa1\x1a\x15\xb4y6E\x8b£\xbbhL\xb4ym\xae9\x9a2\xb9̘vT\xdf\xc7|og\xa2\x17e\rNHK\v\xe2\a*~\x88\x1f\x89\xc2\x1f\xb3\xd4N2\xe5U\xec\xc0U*F0\xaab;\xc6T<\x1b\xe3*l\xe4U\x18ح\xe2'\xf8)\x95\xa5|E֩\xe5t\x15?\xc3\x13*^\x86W\xa8\xf8\xb9\xa8+\xa1\x18\xd2\xec)\x15\xbf\x10\x8a_ʡz\x9d\x91M\x10f\xdde\xfd\x95\x1c\xfc\xba\xe4\xc0\xe5\xf8\x8d\x1c\xfcV\x0e\xea+tLB\xd4e\xe6\xd3)\a\x06\xe9,ѽ\x15\x10\xb3\xcci\a\xb1v\xc1\xe1w*Lq\xe2\xf7\"\xf8\x0f\xf8\xa3\x8a?\xc9\xec\xcf\xd8\xcdޑ*i\xa4i\xf1\xe6\xc0|\"x\rSEVH\x97\x95'jg\xdeH\xa7\xa4Ђy+=̺R\xf1\x17\xfc\x95ݾ\xa3\f\t\xb7\x1e\x948=\xf2oo\u05ed\x9c\xe3\xd1R\xffnWZcz\xef2Dp\xa0\x9dШ\xf8\x1b\x9e\x98\a\xa3\xb4\xd3*x\xf4\x84\xf9\xc2\xdb\xdaf\x99碩B\v\x8f\xea\x19\xaa\"U\xb3gts[\xb4\xb9\xdc\xd8\xf2=\xcf\xd2樖I\x95\x9e\x14\xadmn\x8f\x8eL\xe99=\xba\xd7H\xa7\xa3\x13z\xd4ҧ\xcd=T\x983\xcdL{t(\xad3\xec\xd1|\xce3Mz\x1b/\x99\xf6\xb5\x19\xc9\xd7'\x83\xf8\xbb\xa0\xf7\x0f\x157\v\xfe\xcb\xfc\x85\xe8k\xf8\x12\xce\x7f\xaa\xf8\x17\xfe\x1d\xc4\x7fT\xfcW*k?v\a\xf1\x94\x8a\xff1\x10\x8a\xa2*UJ\xb5\x82-\x03f\xd4\xe9\x1a4Ǟ\x8a^\xa3\xefw\xd5\xe6\xb2zR\xccMр\x8a\x993\x8f\x1d\x8b\xebT\x7f\x12$\xf6\x13\xca}\xbe4\xac/\xdcG\x83\xbe\xdd3\x8f\xff\xaa\x99oxl\xfb\xd5-2.p\xaa8\xe7,w\x94ԲKJ\n\xf7\xaaUpq\x85^\xea\xbf3\xdc\xe2\xaf\xdcL\x1b+\xf5o\xaa_\x9c+\xbf6\x97\xb4\x94>\x14\x84\xaa\xbe\xecr\x95\xe2e\xa7k\xa9\xa8\xaa>W\x89\xb8\\\x84\x94\xf9\xb1\xb4\xeen\x98\xb4;\x8c\xac\xcb\x18\xce\xf9\x17\xee\x89G\x96\xf3-b'\xbehF\xa6,so\xe1)VK1\x89\xe4\x94.\x15\xb9H\xee9>\xa62\xb6\xdb\xef\vo\xa8\xe2˸\xb1@\x9ep3'I\x06\x06\xa4\xc66\x89\x10#\xa49/=\x05\xf1\x93\xba%\xbd\x96A\xc1!ۜ\x8f\xf2\xa6g\x14\xdcc\xdeZ\r\x95\x9e\x9d\n\xce\xf6K\xed\x9aҬ\x84~m^\xcf$\xf5\x12\xb3J^\xf7Kʒ\xd7y\xa1H\x8f\xe5+\xb9\xa5\xe2\xfb\xcbɐ\n\a\x04(\x996%l+\x8f\xffra\x18\x93$\xb6\x9dǬ\x93\xe3'\xfb@;&L\x95\xee\xb9J/\x1dڕ\xb3\x9d\x00\xae\xa9\xf8\xae+s\x1fg\xf0\xaf\xe3B\xfe\xc1\xae\xc5\n\xf4c\x80\xabA\xae\xaa\x10\xe7zȷ^\xce\xf5\x95\xbe\xf5\xe9\\\x0f\xfb\xd6M\\'|\xebՈ\xc8e\xcbyD\xee[\xe7\xcb+\xd7\xf9\xf2&\xe6w\x11u>\a\xcf%\xc7\xf3\xb8\x1a\xc0\x02r\x01+砌ϡj<\x16\xa9\x9eE`\x0e5\xe3\xb3X\xd0:\x8b\xe0aG\xeeN\x8e\v\xc8\x03\xc4p5ǥ.\x174G\x87\xccD\v\xab\x00\x13\x05\xd9\x06\x82\x0eU<6\x83НX>\x87\xda\xf1H8\xf0\x10\x16\x8eW\xc7\x12\xf7C\x9dŢGDo\xac\xa2\xba:Tsl\xa7\x90\x0e\xbay\x8eOm\xdcsMf\xaeZ\xc83\xc8U\xab\xac'G\rw\xee\x89͢\x8e\xa2\x17S\xf4)G\xb0$\xd6v\x04\x91X\xeb\x11\xd4\xc7\xe2G\xd0\xd0J\xa3\xee\xc0\r\xadm\xf7\xa31v\x1f\x8fg\xb1t\x06˜id\xb9|f\xb0b\x16+gp\xaa\xbb\xd7\xe4\xee\xadr\xf6Ns\xf7Nw\xf7\xa2\xce\xde\x19\xee\xdejw\xefLgo\xcd!4m\b8\x9e\x9f\xe5z\xde*\x9e\xaf\b̢\xf9\x91{i\xf1Zy\xe5pt}\xee\xc4)\x1c\xcfG\x00\xcdX\x8c\vЀ\v\x19\xe0\x8bp\x1a֓f\x03\xc1\xdf\xc8\xddM\xe8\xc5%\x84y3\xaeť\xe4滑\xcbp\x13\xb6\xe0nt;8\x9dO\x04n\xc22\xe8\xd8EY\xc4\x02\x93\x98r\x10\xbb\xc7\xc1Nqf\x92(U\xceLP\xac\xe6\x9co2\x8e\x12\xbc\x14\xf9\xe4l\rqi\xe9#X\xeb\x1e\xc3\n'A\xeeC\xfd\fb\xdca\xb8\x023h;\xdcZ\x8cY\xc4\xd1v\x05\xb5o\x85\x8amL\xc7>_\xdc\xd6xV\xd40\x8d\xafA\x9aVLs\x15\xe07\xc3s\xb3\xa0\xfbJg\x0f\x10t#\x91\xf8\fڏb\xc9\x1c:\x98\x9c\xf1Y\x9c=\x8bs\x0eӪs\xcbS\xe5J\xaa\x1cf&\x8e:*UW\x84\xa7ү([P\xf4$sZ8\x0f(}s8\x8fyr~,r\x015\xcc\xe0\xc2\xc8E\x1cb\x91\xf5\xde\xeabg\xb5\xa1d\xb5\xd1[m\xe20\x83K(b3E\\\xda\xdf\x16\xb9\xac\xc6MrB\x95\x18\x0fD\xe2\x89\x19l\x19㪡m\x06\x9d\x0f\xa0\xab\nc\x87\xb0\xb0\xdfq\xa7{L<k\x15\xa7h_\x15,\xe4\v\xe9p\x16\xeb\t\fM\x15+\xb7\x87;\xfd\xf4,Ś\xb3\x18\xd8<+m\x0fW\xd73\xc4W;u\x19\xe0\xf9Z\xa6\x84E\xfa=,\x02\t\xbe\xc0~\xc0à\n/E\xce\xe9\x15E4\xc2\xf2\x16\xf7и\x81XH\xf8V͡g\x9c\x00_\xde\xdfJ{\xaf\xe0o\xeb!\x84\a\xe4\x1bg\xceVѨ:4\x92\xb0\x91qm,\x04a%\xc1\x04\xa1\xaded\xeb(:\u00886\xf2\xdbD\xc0\xc5\xc0\xa8\x03\xf5\xaaB\xed\xaer\xf2OZF\x03\xcd\xddK)\xf3f\xed㏏/\xaf\x8d\x9c˯\x842\xe4\xd6UoyC\xda\xe3\vw\xc8sU\xc1ux\xbe\xc7\xdf\xe1\xf1\xd7\b\x7f9\xf3\xf5>\xe6\x9a\x02\xf3\v\xb8\xefb\x92\xa6\xd1\xd2L\"m\xae\xf6m\x1b\x02\xf1\xd6\x15L\xfbg\xdd[\x96}/&\x967b\t^R(@)\x87\xf9\xb2\x8b\xe0\x85\xc4Wqf/\"m\x953\xbb\x91\xd4\xd5\xe4\v12ct}\x1e\x00\x89?\xff.x\x0eltJ\x13\b\xc6Z\x156\x95r\x17\x0e\xf8*,XP\x18\xc4˝PW9\xfd\xc5\x15$\x18K\x80\xa5\xa1EX\xc0\xed\x03\U00063a09\x1fn\xe3\xd8v8\xc6\x1c\xec.\nw\xc3y\x13\xc3s3\xef\x8cW\xb1\x1d݂z\xdcʐ\xdeV\xc87\x8a*(<\xd5S(\xb3Wr&i\x12!\xff\x80c\xfd\xcd\x05o\x86\xbc[\xa1\xc9i\x8a}5^S\x1c\x0f8\xd5\xe2\xdd\ne.\xde\xees\xb1\xa9\xa0\xb1\xc9Ө\xd0:\xa1\x7f\xf5\xd3PK\x03\x04\n\x00\x00\b\b\x00\v;\x84B{w`~%\n\x00\x00\x0e\x16\x00\x00*\x00\x00\x00org/gradle/wrapper/GradleWrapperMain.class\x9dW\ax\x1b\xe5\x19~\xffX\xf1\xc9\xe7\xb3\xe3\xc8NlgY\x84$\x96\xe5\xc4\"\xac\x90р\xb0\x1d\xe2\x19c\xc7NMhӋt\xb1\x05\x92N\x9cN\x19]\xd0A\xdbt\xb7tҖ\xee\xd2\xdd\x00\xad\xac\xe2B肖.\xba\xf7\xdet\xefM\xfb\xfew\xb2,\xcb\x17\xf0\xd3\xe7\x91\xfe\xf9\xfd\xdf\xf7~\xf3\xff\xef\xc1G\xee\xbe\x17\xc0vѦb\aN\xab\xe8\xc4\xf3e\xf3\x02ټPŋ\xf0b9z\x89\x8a\xcb\xf1R9zY\r\xd7^\xae\xe2\x16\xbcB\xc1+\x15\xbcʏW\xcb\xf5ר\xd0\xf0Z\x05\xb7\xca\xc9\xebT4\xe0\xf5*j\xf1\x06\x05\xb7\xa9h\x92\x9co\xc1\x1b\x15\xbcIE3N+x\xb3\x8aV\xb9،\xb7\xc8\xd1[e\xf369}\xbb\x82w(\xb8ݏw\xaa\b\xe2]*ލ\xf7\xc8\xd1{e\xf3>ټߏ\x0f\xf8qF\xc5\x1d\xb8S\xce)\xf4.\x15\x1fćT\xe41\xa3\xa2\x80\x0f\xab\xb8\x1b\xb3\n>\xa2\xe0\x1e\x15\xdbq\xaf\x8a\xb3\xb8Ϗ\x8f\xca3\x1f\xf3\xe3\xe3\n>!\x87\x9f\x94\x9b\xf7\xcb\xcd\a$\xa3\xfb\xfd\xf8\x94d\xf4i?\x1eT\xf0\x19\xa9\xf2i\xd9|֏\x9bd\xff9?>\xaf\xe2\vxH\xc1\x17\xfd\xf8\x92<\xfce?\xbe\"\x8dĭ\xaf\xca\xfek5\xd4\xfe\xeb\n\xbe\xa1b\x18\xa7\xfd\xf8\xa6\xec\xbf\xe5Ƿe\xff\x1d\x81\x96\x9e\xde}\xd1\xf1\xc1\x83G\xae\x1a\x8d\xf6\f\xf6\x1e\x19\x1f\xeb\x1d=\xb2\xff\xc0P\xaf@`\xf0:\xfd\xb8\x1eI\xea\xe9\xa9Șm%\xd2S\xbb\x05\xd6W\xd2\x1d\x19\x19=0\xd2;zp\xf2\xc8@\xef\xa4@]\xb7\x99\xce\xdazڞГ9\x83\xfc\x17\xd1\xf7\x0eO\xb8\xa4\xd5{\x12鄽W\xa0*\xd41!\xe0\xeb6\xe3<\xb0b0\x916\x86s\xa9\xa3\x86uP?\x9a4$\x0e3\xa6''t+!\xe7\xc5E\x9f=\x9d\xc8\nl\x194\xad\xa9Ȕ\xa5ǓF䄥g2\x86\x15\xb9ʙ\x1ergCz\"Mܾ\x14{\x81աË\xb5r\xa4\xeb\xd6\x14\xf95zl\v\xa8E\xce\xfd\xba%P\xefR$\xccȾD\xd2\xe0n}\xc62\xb9i'\x8c\xac\\\x11P,Ӵ{\x12\xa4mȞ\xca\xdaFj\xa4D@\x04\xee\xf1\x9c\x9dHF\xe6\xd7\xc9fEQH\xefI#\x96\xb3M\x9e\xde\xe4\xa5ܡ\x85T\x12]\xefɘ\x91\xb1\x134<\xfd\x93ѭ\xac1V!v\x9fe\xa6\xa2\x8e\x82\x9b<\rP\x86iHϐgM\xccL\x1f\xe7Y\x830.)\x87\x11K&\"\x95ܻ\xcdTJOǥ\xe3\xba玑\xc7\xca\xd8\xfc\xfa\x88DE^\x1b+yuW\xd2H\xe1c\x89\xa9\xb4n\xe7,ڲ\x7f\tx\xf7,\xa6X\xbc\xb2\x97\x8c\x1b\xf5x\xbc\x12<q\x86\x16zT\x86ÆʵJ\xa7\xaf,\xfa\xa3\x9cQCh\x11\x99\xa0\n\xeb\xddŴaG\xc6G\xfb\xc6N\xa5m\xfdd\xc9e\xa4\xf1'\x19\xdfr\\\x8a\xad\"\xa9\x8c\xad\xa2\x98\t\xc3\xca:\x14Ms2\x16Fh\xddq\x97\xc0M\x1cB\xb1\x8c\xac\x99\xb3bF4K\x1aCO\t\xac*a\xebKgr\xb6\xbḅ\xda\xd1\\\"\x19\x1f5bF\"c\x97\xc8\x1c\xf6\xe5(\xeb]\xa7\x8d\xd3E\xfb\xcd\x14\xd5\xf2\xef\xa1\xff\xdc\x04V\xc7\x1cYn\xf4\xaf^\x94~]\x92\xa3\x86I\\\xa3!\x8eS\x1aN℆)\x9cP\xf0]\r\xdf\xc3\xf75\xa4\x90\xd6\xf0\x03\xfcPC\x0e\xc75\xfc\b?V\xf0\x13\r?\xc5\xcf\x04\xd6xdA\x9f,2ɤ\xc0Z\x8f\xcd\x1e\xf3D:i\xeaqf\xa2\xbbCiOǍ\x12\xc1\xcf\x05\x82\x1e'Ft{:\x9a\xcd\x1a)\xd6\x16K\xc3\xcd\x12\xe4\xa4\x042\x89_\b\xb4{\x9c\xb8\x92)\x9e\xb59\x91\n\x8eٺ\x8cy\r\xbf\xc4\xc3\x02\x17\xfd\x1f\xe9\xb2\x10\x96gbh\xf8\x15~\xad\xe17\xf8\xad\x86\xdf\xe1\xf7\x1a\xfe\x80?\xd2\xf1\v2\x81\xde,\x0f@\x86\xa9˱k\xbeDI\x9d\xfe\xa4\xe0\xcf\x1a\xfe\x82\xbfj\xf8\x9bT\xf5\xef\xd28\xff\xc0\x8d\xac\xc9\xd7\xd2Y\xd6&\x81ڲ#\n\xfe\xa9\xe1_\xf87\xab\xc7R\xaa\xad\x82\xffhx\x04\xffU\x88F\b\xb1L\x11U\x9a\xf0\x89动ք\"\xfctڣ$\x04\xfd=\x1f\x80\xa3\xb9\xb4\x9dH\x19\xa5M\x82\x175\x8aP5Q+\xd1\xfa\x8eQIMh\xa2N`o\xb7\x9eN\x9bv0nМ)Z-\x18K\xea\xd9l\x86\x9e\r\x1e3\xad`\x11n\x905<x\x8c\xe50\x18\xe3usT\xcf\x1a\xc1\xf6\xcd\xd9\xf6.\xa6̼\xd8\x03G\xaf3b\xb6&\xea\xc5\n)\xb1A\x13+\xa5\xb8ֈ\x93*\xdb,7Wʍ*\x02\xa2\x91>\x1c6\x83\x0eI\xb0H\x12\x9cKCBȥ\xe3\x94\xd2\xe4U\xfd5\xd1$Vib\xb5h\x16\xb8\x94<\x8a\xc9\x1cL;\xd9\x1c\xccf\x8cX\xe2X\u0088\a\x13\xe9s\xf0\xefRD\x8b&Zq\r\x8b\x9cG\xfe\xd2s\xddf\x8e\xe7\x16Zh\xce&Ey]R\xd95\xb4D1hr\x8c\xba\xaei'\xd9\x1b*\xafqM\xac\x15\xcd\x1a\x0eb\\\xa0\xb9\xb2 ])1ʠ\xae)\xb1\xd0\xc4:\xb1\x9eE#\xd2\xe52\xd7\xc4\x06i\xd22\xa3\xbbi\u0088\x9e2\xec\xf2\xaa\xda\x12\xea8וY\xcd:\x16\x95U \x10\xaa\xb8\xc1d\r\xaf2svy\xd9\x1b!\xb4\xf9\xb2\xb7\xf1\xb1\xefV\xcaf\xe4\x1c\xaa,\xf4nf\xf5W\xdc\x10ee3J\xfat\\\xbeSxo,\xe9\no\x0f-\xe5\n\x93Ju\x87\xbc8\xf6͕\xbc\xdd^\xbb\v\xaa\x9b\xc3E1\x1c\xd9T\xe3\xb0\xd7\xfd\xea)\u00ad\xb8\x9e{^\xc5Б#\x9f\x11\xc7\x12S\xceM\xbe9\xf4\xd8w\xbf<\xd3H)\xe6\x89\xf1\xf4\xf5i\xeat`\xee]\xb3)Ա\x94\xa7\xc3r\xe7\xf9#\xb0\xc3\xfb\xd9P\xc1\xc19\x16/\xe3C\x0eJ\xf1\xe5\xc3L\\\x04x1}\xf9e\xecV\r\xb28\xffܡQ\xe6ɰ\x87!+\xaf\x8a\xfd\x94\x93\x94\x89\xd4ȤX\xfcri\xf3~\xa5\x94\xbd\xe3\x9cd\xd2-#m\xbbQ[S\x9a\xcb\xcbѰ\x87u'\xbb+\x01\xb2r[F&\xa9\xcb;\xddʒ\xf6\xb2\xa5\x05\xa8\xd7\xd3d\xc5\xfcZ\xb7\xacȮ2Tæ\xb5\xe8\xdc\x1e\xd3}\x9ao\x9c\xcb\xf3,C\xd3Jا\"\x954d\xb6\xe1\xd1)\\\x85凄\xfb\"\x11X\xb7\x88\xeb\xfc.\xf9\xb5\x9ck\x8f7 9\r\x96^f\xa5\xb7\x9d{k\r\ue7bbj\x8bs\x86\x9em\xf26[D)_q-\xe5\xd6;8m\x99'\xdc\xe20\xb1\x90I\x9f럱ش!\xbdRmܐӓ\xb4ת\xd0\xe2(\xeb`\xa5\xaffuJ\xe9\xf4\xceN\x0f\xef\x1c\xf68\xe3\xe5\x9eU\x1eg\x9d\x12\xe1D\x8a=\xed\xbakt\xd1s\xb2\xc3\xeb\xdc9\x9e\x98>\xf75\xd6\x12\xf2ܗ\xd2j\xe7\x8b\xfe)~՝\x9b\xf7B\xf0\x8d\x1e\xfc\xe8\x89XҔE \xfc\xe8A\xbb\xd0\x11\xd5D`\xa4\x8fs\xa0;\xe5[`ےP\x14\xef:\xf9\x8e\xb7Mw\t\xe7\xf1\x9b{\a\x80*\xb4\xca\x1b\x92\xa3VL\xb0\x178\xc4\xf12\xf4q\xfe\xf8\xb2\xf9\x00T\x8e\xf9>f{\x98+\x11\xf6\x82\xfd\xf2\xf0\f\xc4\x1d\x0eɵl\xab\x9d\xc5 \x9e\xc0Vs\t\xf0D\x1ca_\x83'A'\x95<\xfc\x10\xfc\xa4\x04\x0e\x16\xb0l\xb0\xb3\x80\xaa!6\xbe\xe1\x02\x96\xef\xf2\xb5\xfa\xc2\x05T\xe7\xa1l+\xc0\xbf\xf5N\xd4\x14\xa0\xeeZ\u07ba<<\x8b\xda\xc9Yh\x93\x81\xba\x02\xeag\xb0b\x16\r\x93\x05\xac\x9cA`\x06\x8d\xb3h\x9a\x9c\xc1\xaa<V\xcfc\xd9B\x99\xb2\xf5\xa1\x9d\xa3\x10\xea\x11F#\xd5^\x87mD\x18A\a.\xc0\x18.v\xb0\xeeu\xf1\xe0(b\xec}\x18\xe1W\x80A\xfc5T\xfc\x18G\xcbxz\x1f\xbf\a\f\x1a\xac\x11QL#A\xaa\x0er\xbb\x0e\xd7SǤsJ\x90F\x95\x1f\nE=s\xa4\xaeb\xdf>\x8bf\xc2k\x19\x9cE+\xfb5C\x9d[\xf3X\xcb\xff\xbaC\x9d[\xc3y\xac\xcfc\xc3}h;S\x82\xbe\x9a,A\xff\xf8q\x19\x1a\xb0\x13\xab\xb0\vk\xb0ہ\x1atY\x16\xa1\xfa\xd1\x06\x13\x19\x8an@3n\x80E\x0eY\x87\x8fM(\xfcR)\xfa\xeb09Jwt\xd0ȳ\bJ\xcb\x05Λ\xc1\xc6\x02Χ\xad\x8b\x8bᲥy;\xd6;\x02/'\x88+h\xc5h\x99o;\x1c\x8b\x80\x82\xf8\xa5T\x14tI10j\xa9\xd7&\xf77\xaf\x97\x1b\x1e=e,j\x1dCK\x16'K,\xa2E\xbaf\aS\x1e\x9b\xf9\xdf\x12h\x0f\x84\xf2\xe8\x98A\xb8\x92\xdd\xfe2v\xcd%v\xfc\x8a+\xb2\xbb\r\n\xe9\x81~:?\x0fZ}[\x1e]yD\x06nG\x1d=\xb2}\xb2s\x06\x17\x9e\xa5\x8c\x8b\x02\x17\xe7qɭh\x91\x8b\x81K}\xf7`\xc7dUx\xac\x80\xcbf\xb0\xf3l\x11̮\x19\xec>\xe3\xc8j`$\\PD\xb2\x812\x80a\xae]\xcd\xd5\x03hb\x04\xad\xc7(-4\xc6\xd8\x1aw\x10\xae&m\x13#\xea\xc9x\x8a\xe3\xae]x*\x9e渐_~E\xac\x0f3\x8a\xa4\xeb'\x885\xb0'\x8f\xc7\r\x84\x1f@\x9d\x83g\xaf\v\xe2r\x06\xd0\x15\x83\x9dD\x12\xed\f\\\x99G\xf7\xd0\xd69\x8a\x1eI\xb1u\x98[\xbd\xdb\xce\xec\xf2ɾ\xd5wv\xc0ٻ\x8ay\xba\xff,\xb9\xb71\x8c\xa2\x8e\x91\xbb\x9d^z\xb6\x9fq\x1be+{W\x9f\viO0\xd9k\x88\xaa\x8e+\xcd\xcc\xe26f\xf0&\xe6p'C/\xc2\xe0\xdbIK\xef\xa6\xe7\xfb\x99\v\x03\xcc\x03\xa9c\x98\x12:\xa9\xe9M\xd4y\x19OG\xf1\f<\x93\xebm\\}\x16\xe9\x04)ki\x81g;>\xba\xb9\xe4#\x931&m\xba=\xd0W@\xff@\xf8~h\x8e\xbd\xa5\xb1\x03\x03\x05\fN\x0e\x94/\xc9\xc1\x9d\x18r\\1\x17\fk\x9c\x146\xd9f\xc8\xfa\x06\xba\xc1\xa2\v\xb2,\x00\xb9R\x80T\x13\xc6\xcdN\xb5\xf3\xe39\xa5Zv\x91\x13\x1e\xc0\xdaY\fӺ\a\x02#Đ\xc7ՁQ6y\x8c݅!\x99\r\xa2,\xe6\xcec\xfb\\G\xf4\xf3\xfe\aPK\x03\x04\n\x00\x00\b\b\x00\v;\x84BO\xdd6\x04\x16\x06\x00\x00\xd1\f\x00\x00\"\x00\x00\x00org/gradle/wrapper/Install$1.class\x9dV\xe9w\x13U\x14\xff\xbdfҤ\xe9 \xa1l\x16Y\xaaTI\x03m\x80\x82J\v\b\xb4 \x85PJW@T\xa6\xc9#\x1d\x98΄ɤ\xa5\xb8\xef\xb8\xe3nq_\x11ׂ\x10\n\x9c\xe3\xf1\x1c\xbe\xf9\x85\x8f\xfe\x15\xfe\v꽙\xa4K\x1a\xe2\xc1\x9c\x93\xb7\xdcw\xef\xef\xfd\xee\xf2ޛ?\xfe\xbe\xfa\x1b\x805\xb8X\x81:ts\xd3\xc3M/7}\x01\x84\xb1߇\x03\x01\x94\xe3\xa0\x0f\x0f\x05\xe0\xc7~\x9e\x1c\xe2\xd1\xc3~<\xc2\xfd\xa3,9̍\xc6M?7\xb1\x00:\x11\xf7C\xf2\xe4H\x05\x12\x18\xf0Cg\xed\xa3\x01\x1c\x83\xc1˃\x950a\xb1B\x92\xa7\xc7+a#U\t\ain\x86\xfc\x18f\xf1\t?F\xfc8\xc9\xc3\xc7\x02x\x9cw\xeb\xc4\x13l\xf5d\x808>\xe5\xc3\xd3><\xe3ó\x02\xc1!ͨ5\xac\x98f\x1cԓ;tC\n\xdc\x16=\xaa\ri\x11݊\xf0\xbcY\xa0\x92u\xe2z\xcai\xd5m\x81\xb9\xf9\x99\xad\xf7\xa7\x1d\xdd2{lc\xc2ȔN\xa4\xa7\xb3\x8d\x8cʝ\x01=U\xbbZ`qԲ\x13\x91\x84\xad\xc5\r\x19\x19\xb6\xb5dRڑ63\xe5h\x86\xc1z\x1buSw6\v\xb4\x85J)N\xe7Tl\x96ߺ\xaeW@i\xb1\xe2\xe4\xc9\xec\xa8n\xca\xf6\xf4`\xbf\xb4\xbb\xb5~\xf6\xad*ʮ\xf6j\xb6\xce\xf3\x9cPa\xaa\x02\x10P\xdbLS\xda-\x86\x96JI\x92,-\xc1\xa8v\r\x91W\b\x8c\x9c\x0f\x86\xea\nc\x16p\x06\x93\x13\x11\xf5\xc49pez\xad\xc0<W\x91\x02gD\xda\x1cik\x8ee\xb3\xfa\xa0f\x1f\x93\xb6\xab>˔2\x9ej\xb5\x86M\xc3\xd2\xe2\x02\xe2 1s\xacdT\x0eI\x83r@̂S`\xa2\x94\v\xe6b[\x96#\xb0`\xba\x87#ɼ\x975\x05&\x1b\xa7S\xde\xcc,\xb6\x9f\x88\xc9$\xe74\xe5\xc3sD6\uf5e1\x99\x89\xc8\xde\xfe\xa32\xc6;Ut\xe9\tSs\xd26\xa1n\x9d\xa90e\x9f\x98e\xc6Ҷ-M'\xd2B\x91b\"Ŷ\xed\xb2\xd2vL\xbaΫ\xb9\xf86\xb0\x1a\xa5p\xbb\x193\xac\x94n&\xf6Hg\xc0\x8a\xfb\xf0\xbc\x8a\x17\xf0\xa2J\xe7o\xad\x8aU\xa8W\xd1\xc0M\x04\xabU4\xe2%B\x98\xba\x81\x8aS\xd8$\xb0p\x92e\x17\x15\xae\x99ؖ֍\xb8\xb4U\xbc\x8cWT\xbc\x8a\xd7(K\r\xd61\x15\xaf\xf3\xbc\x11o\xa8x\x13\xa7U\xbc\xc5\xcd\xdb,{\a\xef\nx\x1b\x92\x9a\xed\xa8x\x0f\xa7}x_\xc5\a\xf8\x906̧\x8apk\x18lԇ3*>\xc2\xc7*>\xc1\xa7>|\xa6\xe2s|\xa1\xe2K|\xa5\xe2k|\xe3÷*\xce\xe2;\x1fΩ\xf8\x9ew\xf8\x01Q:Y\xadҐ\x0e\x83P\xbdP$-{\x84\xe0~\xc4O\x14\xa2\x1e\xf3\xa4\x9eL\xf2\x1ae\xbaƱh\xe1g\xfc\xe2Ø\x8a\xf3\xb8\xa0\xe2WF\xd9Ȯ..U\xb3T9\x85\xf9\x12XR2a\xc4k\xd2d\xa2@\x04\x16\xdd|\x1f\xe2\x1b\xb3\xa5\xe6\xc8V\xaa3\x81\rEOv\x9f۷X\xe6\x11=\x91\xa6\x83@\xa8\xcd3Α'\xc4\xe7yVB:\x1d\x1a\xd3r\x8b\xc4G\xf3vmP\x16\x96\xa8\x9b[\xbeU\x18ۤ\xb3S\x1f\x9a\xb9<\xd3\"W\rd\xe8w,W$\xb0<T욙\x06D\xd4*\xf5Tk>[Y\xbatX\xcb\xf5\x94\xcbs6\xf1\xdcڟ\xb2\x8c\xb4#;4g\x80\x02\xa3\xc5b2E\x17\xe3j\xba\x1a\xa3\xb7|\xe5\x15qc2V\xe5q\xae\x1f9-\xc7]#)G\x0e\x121+M\x99\x98?a\xd2A\x10\x0e\x01Im\xb0\xb9 J\xb9c\\*Js\x8b\xc0PV\x92<3\xa86\xe6\x17\x8bz\xef\xa4\xf7k\xd8\xfb\x8d%\xbd\xaf+\xba\x98?i\xcd7\xa9\xf3\xc9K\xd3\x1f\x9f\x18.\vM\x7f!\xa6Ǎy\xf9\xa9\xb4\xa8\x9c\xba-\x819\x05Y\xe7|\xe6i\xaf\xfdo\xda\x05\xc63\xaf\xe9ۦKho=\xf7\f\xd0\xdd\x1d\xaa+\xfe<T͔R\xb8\a\xb4T\xbb<A\x10\x8a\x99\xed\xf24\x1b\x99f\xfd\xadМ\xe2\xe3:6n\xfe\xdfo1GS\x9d\x1a\xedI\xe4\xf5\xb7L\x8bO\xbe{\x91\xb4\xcba\x16\xa1\x86>_\xc2\xf4P\xaf\x84\x12\f\xf2\xed\x0fPߐ\xeb\xe9\r\xc8\xf6\xf44\xd0\a\x9a\x87\xfe\x8dXG\xda\xebiԊ2x\xa9_\x14^y\t\"\xbc\xea\x12\xca\xc2\xf5\x97\xe0\tW+\x97\xa0\x84/\xc3{\x9eV\xcbp/\xb5\xe5d\x03B\xbb\x8fZ5;^\x84\xfb\xd1\f\xfeD\xa0;\x96\xb4\b\xb1l7ᕓh\xe4\x1a\xca\x0f\x84/\xa2,\x03\xdf5\xf8\x0f\\F\x85;\vdPY\xa5R\x93\xc1\xac\xcb\xf4\x85DRO\x06\xb3G\xb1de\x06\xc1Q, \x81`\xa1\xbb0g\x1cUc\xaee\xf0\f|\xcaY(\x9eM\x8bGq\xa4$\xfe\xdc\t\xfc\xf6\xfa\f\xe6\xf5]\xc0\xfc\x9cR\xd5\x02Z\"M%\x83\x85\xa4\x91\xc1\xed\xbc\xdf8\xaaYV\x7f\x05\x8b<\xa8w\x81\xee\xe8\xcb3\x19\xc7\xe2vZZ\"ФT+W\xb0T`\x14\x1bx\xb4L\xe0w\x947y\xa7\xe0\xd7\x10~\xb5\x97\x88\xbb\x14r\x1bT{\xc7qg\xdf\xd9\x7f\xaeOѼ\xcbeR\xe6\xeaV-w\xa7\x9eBSVqY\xd4N\t\r\xf1\xbf\x9bBӤdѕq\xdcC\xe1[\xd1W\xad\x8ce\x93\xcc\t\xeb\xc5\xfc\xec\x17y\x88\x12\xbf\x81\x92\x1e%\xe9!J\xd9a\x9a\x9dB\x13=\xea\xcd8G\xa9\xbbJɻ\x8e-\xb8\x81\xad\xf8\v\xdbD\x00-b\x1eZ\xc5Bl\x17۱Ctc\xa7\x18@\x9b8\x8e]b\x88P\xb8\x00:\xe9\x03\xfc\x14\xd9o\xa6\"\xf3\x90\xd5\n<@#/\xfe$\x9c-\x84\xa3p\t\xe4\xca#$\xf6a\x1b\xad\n\x1c\x12KВ-\xba\x1b\xf4\xc2\x136<\x84<\a\x0fҪ\x82\x9d\xb9\xc2r\xd7\xda\bw\x17I\x14\x92\xec\x0en%\x9eQ\xb7Ĩ\x04E\xb6\xfc\xbc\xe1\fBc%\xeaӛ#0\x01C\ud7ac~;\xf6f\xfb\x0e\xecˮu\xa2\x8b\x02\x02\x04H\xab\x0e\xd9߿PK\x03\x04\n\x00\x00\b\b\x00\v;\x84B\x1a\x88\x80\xfc\xb2\x01\x00\x00V\x03\x00\x008\x00\x00\x00org/gradle/wrapper/PathAssembler$LocalDistribution.class\x95R[K\x1bA\x14\xfe\xcef\xdd\xd5t\xabqk\xb5j\xd5xy\x88\xdbڭ\xe2\x9bE\x10E\x10\x82-(>\xf8 \xcc&C\x1c\xbb\xee\x86ɦ\xfd[-4\x14\xfa\xd0\x1f\xd0\x1f%\x9e\x19\x834*\x04\x1fv\xe6ܾ\xcb\x1c\xf6\xdf͟\xbf\x006\xb1:\x86\x11̕\xe1a\xdeDoͱ\xe0c\xc9G\x95\xe07U\xa78Wm\xc2x\xfdJ|\x13\xb1\xca\xe3C\x95ʝ~\xeb@i\x82W\\\xaa\xce\xdaG\xc2J=\u05ed\xb8\xa5E3\x95\xf1w-\xdam\xa9\xe3/\xa2\xb8\xdc\xebt\xe4u\x92J\xcd8\xef\x93\xcaT\xb1Kث\r\x1f\x1f\x14\x1d\xcc\xd6\xcf\b\xee~ޔ\x84\x89\xba\xca\xe4q\xf7:\x91\xfaT0\x90\x10\xd6\xf3\x86HτV&\xef\x17]c\x940i{\al_\xab\xa4[\xa8<#\x04GY&\xf5~*X\x9aG\xb6\x87Z[{D\xc2o\v[\xb2\xf8\xbfd\xd7S\xa9\xad?\xdc]\x99\xe7x\xab&\xe3\xe4$\xefꆼK\xc2\x01\x91\x0f\x06\x17\xa0\x8c\x17\x01\x02,\a\x18\xc3h\x00\x1f\xa3>V\b[\xcf\xf7\xc8n\xac\x95Td\xad\xf8sr%\x1b\x05\xa1T3\xab\xac\x0ec\xc32\xff\x19\x1e\xff3%\x84\xc6\x03G\xa11\x04T*Ƣ\xed\xf0&\xf1\x92\xcfq\xce.\xe0\xc0\xe5{*z\xd7\x03E\xbf\xe1D\xef{(E\x1b=\xb8?\xb9\xee`\xc2r\x98\x99\x84\x99\x1a\x8cj\xe2\x15$*\\\xa92\x1bc1\xc9\x1fld\xb4\x1c\x1b\x19u\xa3\xf5\x1a\xd3}\xad\x98o\xe2{$\xfa\x85ҏ{r\xcf\x16\xbfZ\xc2\xe0n\xa0OH\x98y\x12\xec>\x04\xeb'\xc0\x0e\xde\xd8s\x96݂\xdfN\xdcY\xe4\x97\xd0-PK\x03\x04\n\x00\x00\b\b\x00\v;\x84BI}\xc8#8\a\x00\x00z\r\x00\x00!\x00\x00\x00org/gradle/wrapper/Download.class\x85Vi{\x13\xd7\x15~G\x964\xa3\xf1 ۲q\x10\x0e\xc5q\x00\xcb{!m rB\x8a\x8d\t\x02\xc7P+v*hK\xc7ҵ-\x90f\x94\x99\x11K7\xd2%M\x9b\xa6KڤYJ\x93\xeet/\xa4 S\xec$}\xfa\x81\x0f\xfd/\xfd\vm\xdf;\x96%\xd9衖\x9f;\xf7\x9es\xcf2\xef{\xee\x99\xfb\xaf\xff\xdc\xfd\x10\xc0~\xfcC\xc7\x00D\x04CX\x94Ò\xceaYC^\xc7y\\PQС\xa2\xa8Â\xad\xa3\x84\x17\xe4\xe0\xc8=\xae\x0e\x0fe\x15\x17U\\\xd2ц\xcb:\xa2\xb8\xa2\xe3\x8b\xf8\x92T\x7fY\xc3Wt|\x15W\xe5𢎯\xe1\xeb!\xf8\x7f3\xbd\x11\xba\xfe\x86\x86o\xeax\t\xdf\xd2\xf12\xbe\xadaT.\xbe#\xf7\xbd\"%\xafh\xf8\xae\x86W5|O\xc3\xf75\xfc@\xc3\x0f5\xbc\xa6\xe2G:~\x8c\xd7U\xbc\xa1\xe2'*\xdeT\x00\x05Fʲ\x843Y0]W\xb8\n\xfa\xd2W\\O\x14O;vI8^^\xb8\x9c]\xber\xa4\xec-\v\xcb\xcbgM\xcfv\x14DOϞzfv*\x9d>7y|n\xe6\xa4\x02%\xa5`ۤm\xb9\x9eiy\xf3f\xa1,Zd\xaa\nZ'\xe6\x8e\x1d\x9b\x9a=\x97N\x9d\x99\xa2\xa8\xbf]A\x9bY*\x15\xa4\xa7\xbcm͘E\xa1 6}\u07bch\x8e\x15Lki,\xed9yki\x9c\u0086m\xf3\xc2q\xf9P\x10~2o\xe5\xbd\xc3\n\xfa\x13\xf7\xdb\xdc/\x19\x98W\x10\x9c\xb4s\x8c\xd16\x9d\xb7\xc4L\xb9\xb8 \x9c\xe7̅\x82\x1f\xd5Κ\x85y\xd3\xc9\xcbuU\x18\xf4\x96\xf3Da״\xed,\x8d-9f\xae \xc6.9LE8cG\xedKV\xc16sL\xee\xe1\xacm-\xe6\x97ʎ\xd8\n\x8f\x9ffKBF\xd6rU\x03\x05\xbb\xab\xe9Z\xc2\x1b\x9b\x9bMUS\xcd\xdbc\xc7\xf2\x05᧩\x9a\xb9\x9c#\\Ǝn\xdeJ\fs\xc2\xf5\xf2V\xd5wt\xb3\xad\x02}\xearV\x94\xa4\xd2U\xf1\x96\x82\xf6\x8d\xb0)\xcb\x13\x8ee\x16\x98O\xd9)lq<M\xcbh\xd9\x15Α%Q\xa5\x8c\xf0.\x94\x17\x17\x05\t\x0e\x9c\x9d`JV\xb98+d\xfam%\xc7^\x92\xd9M\xdae锄\x9f\xa0W\xbb\xec)\xe8\xae\xe5s\xaa\xec\x95\xca\x1e\xa1\x17f\x91ރĈ\xf9\xee\xd8\x14\x955b\x89\xacL\x96;\x02y\xea\xb7\xd7\xecSV\xa3y\x8c\xe4d\xcb\x05\xd3\x13s\x1bY*\xe8J\f4\xab\x15\x9d\x04\xd5jD\x97\x1b慕\x93\x95ں\xbe\xa8\xaa:\xea\xaazI\xd9\xeez\x15Fl\xb7Qx\xc4\xc9.\xd3Y\xda.;Y!\xa1f\x85oT\xc0\xa8\xf4c \x89\t\x03\aq\xc8\xc0\x13r8\x02\x82\x16]\xf6\xbc\xd2hIV\x85\xcc[\xc5\xdb\x06\xde\xc1O\x15\x1c~@E\xed\xf9\xff\x87NF\xbb\xa6\xe2g\x06\xde\xc5{*~n\xe0\x17\xf8\xa5\x81_\xe1\xd7\x06N⨊\xdf\x18\xf8-\xae\xb347\xe0\x9c\xf0\xb9\x14\xb9FZHGc\xf54\xaad\x80\xdf\xc9\xe1\xf7*\xfe`\xe0\x8f\xf8\x93\x8138K\b\xe4{\x8c\xf8\x04\xa8\xf8\xb3\x81\xbf`\xdc\xc0_qC\xc5M\x03\xef\xe3o\x06\x9e\xc3-VĨ\x8a\xdb\x06*XQq\xc7\xc0\xdfq\xd7\xc0*V\f\xacIX|\x1eF/VY1\xaa\xab*\xdam\xeb\xcbb]\xa2\xda\xee\xa8峢s\xb6Il\xfa\xbc\xf4\xecu\xc7\xf6\xba\xbd\x89\xbd\xee\xb8\xff?\xd00\xe5\x11\xa8\xd7ȩ\x85\xf3\xac7\x15\x1f\x18\xf8\x10\x1f\xd1\xf0\x014\x10\xbc&\xdaT]\xbd\xebA\x1c\xeeW\xd0Y\x8f[;\x94\x9b\xb2Y\xe7\x99p,\t\xaf\xca\xf6\x15\x05\xfb\x9a4\xb3\xa6uޗxP_ڳ\xdf\xef$ݵ\xf3\xb6\xa5k\xeb\xae\xf0\x8e\x8aE\xb3\\\xe0I\xeai\xe8H\x9b\xf6\xf9>\x8c\xc6*a\xe1\xcbtM\x87{\xd6\xd7\xed\x1bǰޅ\xc2\xc5\v\xb9\xbc\xe3\xfa\xbd\xef̆\x83j\x0fS\x10\xf2l\x1e\xfe\x06\xc3z\x13\xeaH\xdc\xdf\f\xe3\x89\xe6M\xa5\x9eZ՞\xe7\x8d Z\xf5\xae\xa2`\xe7\x96\x10\x9b:Nws\r\xbb\r\xb1\x99\x15/\x94\xd9i\xeb\xbcD\xf9\xda\rMI\xc1C\rｹ[u6\x11\xb3\x05:~\xfb\f%\xceN\f\xa4\x1a[\xddi\x12\xdaĸAL\xab\x92\\ѪYu\x10\x88\xaef\x10\xd1쒓\xf7ȑʠ\xa9\x94\xffi\xf1\x1d\x15\xf8\x96\xa1l\xc1v\xc5\xe6\x8a\xf4\x1d\x92\xbfE\xdb)\x9a\f\xf7D\x93pg\xa7\xb7\x1e\xa8f\xf5\x89Gxs\x19\xe0\x95b\x18A\xec\xe4\xe5\xe8\x00\xe7\x8f\xf1\xce\x12\xc0'\xb8\xfed\xc3\xfaq\xc4d\xe3\xe4<&{'\x9f!\xea\x92\x18\xe7\xf8$W&\xf7\xf0\xba\x80\xce\xc1\x15(\x83C\xb7\x11\x18\x1c\xbe\x8d\x16\xae\x827}\aOq솼\x13\xedf\xb0^D\x18<\x8a>\xba{\x14\x87)\xed]7\xc7\xd3\xf8\x94\x7fm\xea\xf4\xc3)\xfeL\x06\f\xf0\xc7~]\rw\x8c\xbb\xa5\xae+\x16\xba\x83\xf0=DW\xa1f\x94\x15hw\x10\xa9ǋ\xfa>\xf7AC?\xbd\f\xf8q\x8cu\xbbj\x1c\x05\x938\xca\xdd\xd2\xe7\x1cw\xcb\xfdm\xc3\x15\xe8\x15\xb4>?84\xbc\x02c\xab\xbba\xba\x1b\xe1|\xb4!\xed\xb6Z\xdam\x98br\x8a?{\x06\xc7i\x99\xe2<H\xc9\t\xceOn\x04S\xfeI(\xb6Q\xb3\xa6\xcc(\xc9\xd0P\x05ے\xe1UD3\xabh\xcb0j\xfb\n:f\xe2\xe1\nb\xc9 1\xecL\xaa\xf1`\xac+\xaeV\xb0=\x1e\xac\xa0;\x19\xea\xe8o_ӒZ\xe4\xa0\x1e\x0fŵ\n\x1e\xca<\x1e\t\xbc\x87\x03\xdd\xfa\xf6\xc8K\xe6A\xbd[\xef\u008eb\xe4\xf5w\xd1\xf9>v\xc6z*x\xd8\x17\x89\x83\xfaH\\k\xd9\x1e\xa9`\xd7\xf5\xffޓ\xba\x8fU\xb0;\x1e\xba\a-\x1e\xaa\xa0w\xe4\x1e\x06F*x\xe4:\xfa\x92FS\xb5*\xd5q㣛|\x97\x10\xae\xe2\x1a\xdf\xe3\x1a\xbfr\xd7jHY\xe8\xe1x\xc0\xaf\xa3\x10k\xa7\x95d\xf6\x90\xc4>R\x99`\xd5\xecg\xdd\x1c\"\x0eO\x13\xc3\t\x8e\xa7\x89\xdegHo\x96\xab\v\xa4\xc4%\x8aW\x89\xde\xcbD\xefU\"\xf7\x1a\xa6\xf1\x06\x9ee\x84\xe3\xfcf\xa6\xf8\xad;\x81\x1b\x94ݢl\x95֒\x89\x12\xa3\xb4\xf2\x8e>MY\x98Q\x9e\xc7\fc\xa8>3\xa7\xb8G\xa3\xe61|\x9aYE\x18\xe5)\xcc\"\r]2P\xe3n\xad\xc6\xddZ\x95\xbb\x00\xee\xf2[)\v\xa3\x8fw\x82y\xfa\f\xf2}V\x98k\x86\xcfFf\xf9᭖濩\xd1\xe4\x89\x18\xbc\x85\x96\xe9X\x1f\xeb\xf3\xd9أ\x1cgb{8&\x83\xb1\xbd\xf2\x11\x8a퓏p\xac_>\xd4X\xa2]\xfb\x00\x03\x99\x16Z\x05ҙ\xe0P:\x13\x8a\x87ҙp<\x9cΨq5\x9dцә\xf6\xf0\b\a\xd6B\xfa\x0e\x06o\xd4\xe0\xee\xf3\x03\xa6\x19x\x8e\x10̣\x83\x89\xee`\x92\xbb\x99\xe6>\xa66\xc2\xe4$D\xb3\xeb\x89U_8\x84\xcf\xf2'Ϙ\xb4\xf9\x9c\x7f\xc6:\b\xd4\xe79k\xa1}\n\xe78\v\xd2\xcb\x04\xbe\xc0Y\x88\xbe\xc6i}\x88\xf0\x8e\x90\xd8\x05\x1f\xde\x00I\x93y\xe40\xe67\x87\x00uC@\xbbF\xdd\x10>\x0e\xfd\x7fPK\x03\x04\n\x00\x00\b\b\x00\v;\x84BU\tHPQ\x00\x00\x00O\x00\x00\x00#\x00\x00\x00gradle-wrapper-classpath.propertiesS\xce\xcdO)\xcdIUHIM\xcb\xcc\xcb,\xc9\xcc\xcf\xe3R\x0e\xc9(Up,(R00Q00\xb722\xb122Vpv\r\x0eQ0204\xe6*(\xca\xcfJM.)\xb6M/JL\xc9I\xd5M\xce\xc9\xe4**\xcd+\xc9\xccM\xb5\xe5\x02\x00PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\x88$x\x14\xca\x00\x00\x00\x1d\x01\x00\x00\x18\x00\x00\x00build-receipt.propertiesmO\xbbn\xc30\f\xdc\xf5+\x81\x05Yrd{В-@\x90\xa5Eׂ\x92\x18GA$\x19z\x18\xfd\xfc*I\xb3\x95\x9c\xeeȻ#uuw\xfb\xe9<\xe6\x02~U\x9c\xf5\x82\r\xad\xf7\x9c\xef\x87\x1dkEL\xf4ޕ\xa3U(\xf9\xc8$\x13L\\\x04\f\xdaLz\xb6v\x16\xd3\xd8$\xd3|i\xa4\x86Q\xf6\x82\\c.\x01<*\xfdp\xcftI`\xefHcZ\x88\xcb\x1f\x01\xd6|\x8dE\x95T\x91\xdc`\x83/L\xd9Šz:R\xf6\xcd8\x89\xf9\xfcP\x9f\\\xa8?\r\xbc\xe7\x9cJ*\xa6\xae\x97]ƴa\"k\x8a74E\xbd\xfcI2\xe7\xea5&Ej[x\x1eP\f,\x18\n'\xdb\xcb\xe3\x00\x19[\x8e|\xe3?Ac\xba\x7f^\xff\x05PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x0f\x00\x00\x00org/gradle/cli/\x03\x00PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\x88\xee\xf2`\xe7\x02\x00\x00\x90\a\x00\x001\x00\x00\x00org/gradle/cli/AbstractCommandLineConverter.class\x95UmO\x13A\x10~\x96^_8ˋX\xa1\xa2B\x01\x91\xf6\xdaR@E\xa4\xb5\t!\x1aI*\x12iH\xfcx\xbd\x9e\xe7\x91\xf6J\xaeW\x94?\xe1o\xc1/%\xd1\xc4\x1f\xe0\x8f2\xce\ue775\xf4N(\x1f\xba3;73\xcf3\xb3\xb3\xdb_\xbf\xbf\xff\x04\xb0\x8e\xb2\f\x19\xabQ\x14d\x8c`U\xc6(ָ\xb6ε\r\xbe<\xe1\xcb\xd3(\x9eE\xb1\x19\xc5s\x86HɴL\xa7\xcc\x10Jg\x8e\x18\xa4\xddV]g\x98\xa8\x98\x96\xbe\xdfi\xd6t\xbb\xaa\xd6\x1ad\x99\xaa\xb44\xb5q\xa4\xda&\xdf{F\xc9\xf9d\xb6\x19\xf2\x95\x96m\x14\f[\xad7\xf4\x82\xd60\v;\xb5\xb6c\xab\x9a\xb3\xdbj6U\xabΓ\xed\xb6\xacS\xddvt\xbb\xc80}9\xd7\xd9\xc9\xdf|\x1b7IT\xaaV\x8be\xca\x16\xd5\\\vC:]9VO\xd5BC\xb5\x8c\xc2\x1e\xb9\xf0\xac\xc5L\x9f\xf1]\xedX\xd7\x1c\n\x92T\xdb 扠\x00jʉj\xb7u\x9baq\x90P\x1f\x91\x03\xe1C\xdeA\xa8\xa5>ۡc\x9b\x96!\xb8ʯ\xbeh\xfa\x89c\xb6\xacv\x14[\f\xa3\x87\xa6a\xa9NǦ\xe2\xf3C\xe7\xc9P\xe5\f\x9b\xe9Ar\x82Q\xbd\x8fbp\xed\xbe\xa2\xfcq\f+\xc3d\x17<\xb6\x03\xbb\xee\a\x0e\xe4\x12q\xe8 t\x87\xcfW\xc0א\x00X\x1b\xba1\xe4\xeer\xbae\xe9\x9f\xf7\xac\xb6\xa3Z\x1au6\x91\x0e\xc4\x0e\xa7]\xe7\xf7\xa5\xea\xb6\xff{\xd9o\xbab\x18|S)\x1f\xb6:\xb6\xa6\xbf6\xf9\\/\\5ǫ\x1c'\x8e8\xc6\x18R\u05cd[\x1c/\xb0\x1dG\x11\xa58\xee\"\x15G\x1a\x19\xae\xbdd\xc8\xde\xe0\xee0L\x0eVǰ4Dy~\x98>\xaf\x1d\xdb\xe84u\xcb\xe9\r9\r8]Ώ\xa6!\x06|\xd97R\xfe\xdb\xc4ߠ\xb0\xb8}\f[\xff\xb9\xceCL\xef\x9b!\xa6w\xa8\t\x95\x16轔A\x13E\xefk\x98$\x1d\x13\xad\xe3\xb4\xdb!ɸU\xb9\x00\xfbFJ\b\x13\xb4F\x84q\n\x93\xb4\xc6]\aܦ=p\xe7\x92%A\x92\xd1\xc9MS\x1cOwF2Dr\xe6\aF>\\ \xf4V\xc9u!)\xb9l\x17\xe1.\"\xe7=\x80q\xe1\x96@\x8c\x82\xc7(\x9c\x03\xa5\xdcP\x0f\x88k3HR\xfa\x18E\xdc\xc3,\xfd\x05p\xf0i\x92\xaeW\xa2\xe7u\x9f\xbc\x1e\x90.\x91|\x889\xd2F0/\x88\xa5<b\a$y\x9c\xacd\x95.\xa2]\xc4\xce\a\x8a\x9d\x15\x1c\xdc\xecr\x8f\x83L\xd9\x17\xe8\xfb\xbf\xb2e\x0fy\x10oQ\xe0-yx_IJ$\x93^#\xf6\x95<oD\x9e7\"w\t\xdd\xed\xc4\x1cU9O\x9dH\t\x16\x8a\x1b\xdbc\x91\xf4:\xc1\xb5GX&\xb4\x18\xf9\xf1\x9e\x84\x043\xb7s\xc9^O\x92^O\\\xff\xc7\xe4?\xc8vE\x92\xf8\xad#\x8b\xbbW\xe8\xe7jY\xe4\x84\xcc\xff\x01PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B2_e\x8f\xa6\x00\x00\x00\xe8\x00\x00\x00(\x00\x00\x00org/gradle/cli/CommandLineParser$1.class\x85\x8cA\n\xc20\x10E\xffh\xb5Z\vv庈kC\xcfP\x14\x04E\xc1\x13\xc4v\xa8-i\x02I\xf5p.<\x80\x87\x12S\\\xbap>\xf3?\x1ff\xde\xeb\xfdx\x02\xc8\x10\x87\bCD\x84\xe8ln\xb6\xe0m\xad\x98\xb0\xc8M\xdbJ]\xeek\xcd'i\x1d\xdbu#\xef\x920\xdf\xe8B\x19W\xeb\xea\xc0\xddՔ!f\x84\xa5\xb1\x95\xa8\xac,\x15\x8bB\xd5\xe2\xe7y\x95\x11@\x88wZ\xb3͕t\x8e\x1d!\xe9\x91BI]\x89\xe3\xa5\xe1\xa2#\xa4\xffHI\xea9\x03|g\xe8\x05\xdf\x02\x8c|\x06\x18{\x9f\xfa\x8d\xfc\r\xf5-\x99|\x00PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\n\x82\xbb\xaa\xa1\x02\x00\x00\xf7\x06\x00\x00<\x00\x00\x00org/gradle/cli/CommandLineParser$MissingOptionArgState.class\x9d\x95kO\xd3`\x14\xc7\xff\xcf6\xba\xad\xebd\xa0 ^\x99\x04e\x17d\f\x11\x10\xd0D\xe7%Ɖ&3\x98\xe0\xabg\xac\xd6j\xf7\x94\xb4\x9d\xf1\xf2\x95|!\x89\x8e\xc4\x17~\x00?\x94\xf1<m\x99\x83-\x19,M\xdas\x9e\x9e\xf3;״\x7f\xfe\xfe\xfa\r\xa0\x8cM\x15\t\x14T$QL\x924/՛*RX\x90g%)-Ʊ\x14\xc7-\x06\xc5\xde\xf3L[ı\xcc0\xf6\u0097_r\xc7՝\x9a\xc7=\x9dA{*\x84\xeeT,\ueeba˰\\\xb5\x1d\xa3d8\xbca\xe9\xa5]\xcb,U\xecf\x93\x8bF\xd5\x14z\xe07\xdb\x03٠(\x9b\xa60\xbd{\f\xab\xb9a\x00\xf9m\x86X\xc5nP>\xa3\xd2p\xabլ\xeb\xce+^\xb7\xe8d\xbcj\xefrk\x9b;\xa6\xd4\xc3Ø\xf7Τt'\x9e\x9b\xaek\n#@\xdew\x8c\xb0\xaa\xb5\xc1Y\xf4\xf5\xa4R2M\xfe\xb9\xae\x93\xe6x\xc1;\x8a\x92\xab\xbe\xe7\x1fy\xc9\xe2\xc2(\xd5<\x87\xdc6\xf2;\fQ\xee\x182\xbf\x9e\x97\fi[\x1cA\xec\xf4A\xf4\x81\x0e\xd7\xfd\x94-\xb6lq\x18*ud\xbe\x0f\xfa\xe5>8\xcc\xd1\x00\x19[t\x99<\x12\r\xaa=\x97ߎ\xe36\x03\x18\xde\f5\xf4\xc1>e\x7f1\"\x9f\x16\xe5\xad\xcc0{\x12\x17\x06\xb5f\xb7\x9c]\xfd\xb1)\x17e\xb2\xc7fA\xf6C\xc3(2\xf2\xb6\xa2A\xc5\x19\r\xab\x98Ұ\x86;\x1a.ᲆuL\xc7A\xac\x95\xe1\xf6\x88a\xfe4-fX:}\x03\x19f\x06\xb7\x83a\xee\x04\xc3\t\x1a\x9d0\xdd\xc3\x1dR\xfd\x81\xefY\xba\f\xb3\x98;\xf5¨~+ZM]x\f\xd9A\xce\xc8\xd2\a,\t\xb9J\xe3r\x1a$)\x88\xc8\t\xd1\xc9\x18iOH\x8b\xd03U`\a\x88\x14\x8a?\x11\xdd'5B\xe6 \xf3(y\xea\x18ao\xa12\x03g\xe9l20\xc79\x92\xe0K\x12\xcb\xe8:\x8f\xa9\x10\xba\x1eB\x95B\xb1\x8dط\x0eO\x81\\\xea\x0f]\x1c\xa5\xc3Qp\x01\x17}\x0emI\xc8yF\xf1)\x03\xa4\v?\x10mc\xa48߆\xf2\xfd\x18n\xcf\xc7e\x03\xc3\x0e.\x1d⤤\x92\x14!\xf9\n\xa6C\xf0\xdd0\xc1\xa4\x04S\x8e\xf1\xe3\xd0VW\x8e\xc9\x0e4\xd9\xc91\x8bk!j\xd5\xd7C\x14\xe5\xf8\xfa\x7f\xfbT\xe9ξ \xc1\xbe\xfa8-0\fp\x19\xd0\x14fC\xc8C\xb2\x8c\x06\r;\x00\xdb?\x96L\xbd\xab\xc2\xee\x86]\xf7\x1b/\xa5\x1b\x98\xf3+\xca\xf9\x9ey\xaa\x19\x98A\x8c\x86S\x86\x16\x93?\xb12& \x7fae\\%}\x86\x9e\xc8$\xfe\x01PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\x1e\x83\n\x97\x93\x02\x00\x00\x83\x05\x00\x00=\x00\x00\x00org/gradle/cli/CommandLineParser$OptionStringComparator.class\x9dTAO\x13A\x14\xfef;eam\xa1\"TE+(\x15\n\xad,\xa5\b\x92\x12\"ibBl\xc4\x04C\xa2\xb7\xa1Lʒe\x97\xecn\x89\x7f\x05N\xde<؋\x17H41\x9e\xfdIF\xd17۵\n\xad\xa9\xf1\xd0yo\xde\xfb\xe6\xbdo\xbfy\xd3/\xe7\x1f>\x01(⑁\x01\xcc\x180ԢcVG\xc1\x00ǜZL\x1d\xf3*M\xb6\xa8cAG\x89\xa1o\xd5r\xac`\x8d!\x96\x9b\xd9f\xe0\x15wW2\fU-G>k\x1c\xecH\xef\x85ر)2\\uk\xc2\xde\x16\x9e\xa5\xf6Q\x90\a{\x96ϐ\xde<\f,\xd7\xd9\n<˩W܃C\xe1\x89\xc0\xf5\x18\x12\x1b\x8e#\xbd\x8a-|_\x12n\xa5\xeazu\xb3\xee\x89][\x9a5\xdb2\t{ \x9c]\xd5\xec\xb9\xf0|\xe9e\xbbW*3\xe8\xb5pGM\xa7s\xd5}q$L[8u\xb3\x85,wFf6\xe8\x8c\x1bV+*\xf6\x1d\x80vz\x81D\xf0\xf7\\/ \x1c{\xf5k\xb3p\xa9\xd1\xe6ξ\xac\x05\xe5Έj\xa4\xbd\x9e\xbf\xd8#ʩ\f)\xbdȀ\xb0\\\xcf\xcf/\x96\xd5%d\xff\x05\xc80\xb0e\xd5\x1d\x114\x94(\xab\x7f\xa3\xda\b,\xdb\xfc\xad\xe4j\xa7\x10kT\xca\xd8r\x1b^M>\xb1ԭ\xa6;\xdaͩC\t$\x90L\xe0!\x96t,3<\xeeɱ\"|\xb9\xe1\xf8\xd2\xf1\xad\xc0:\x92\x9d\xe31\xde\x03\xa1:N&0\x8a4C\xea2o\x86\xe5\xff\x9c\xa6\v\xc5Zb1\x8ctS\x8b\xe1^\uf6e0\x89\xb1\xa5S\x0f\xf6\xc27D\xd30\xd1\xeb\f\xe6\xe9\x11\x1a4\x12W\xe8\xc9rhJX\xda\r\xd2\xce$Kӂ\xf8\xec\x19\xb4\xf7\xe4h\x18\xa2\xb5O\x05\xd9W\xa4\xc8O\xb4\x00\xb8\x8a\x11\xa8\xb9\"y\xa2\xc3\xef\x10\xa38\xb0\x9e?E\x8c\xbf\x81\xce߂\xc7\xd6\n\x7f\xee\x96x\xe6\x18\xc6(?A\\kfN\x94{\x8c8o~\x04\x7f\xc9\xce\x10\xcf\x13\xba\xaf\xd9n|\x87\xfeC\xc0\xbea\x90}G\x86\x9dc\x8a\xfd@Nc(j\x1aJ\x1a\x0f\t-\xb6\x9aF\x84\x94w\x1d7B\x92\xeb\xb8I\x9eF\xe4J\x18\xc3-\xa2\x97A\x16\xb7\xc9\xe3\xa9u\"\x9e\x89\x88?\xa5L\x8clr6\xff\x19z\x81~\xa7\xe8ov\xfd\xfa\x89\x16\xb0\xdd,I\x14\xc7\xc3fIʍCK)\x85&\xa3\xc2+d\xb5HN\xd6]\xcet\v\xd0.\x18\xa7\x82Y\xca\xc7p?\xc4Oa:\xb49\\#;F\xf1\x01\xe41L\xde]\xb2H\xf5\xd3\r\xe6\xf1\x00c?\x01PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\x97\x9b\xc8\xcbF\x01\x00\x00K\x02\x00\x001\x00\x00\x00org/gradle/cli/CommandLineArgumentException.class\x95\x91\xcdJ\x031\x14\x85O\xfa3\xa3\xb5\xb6Zmłbwڪ\x83\xebJADA\x18\\\xd8\xd2}:\r\xd3\xc8L\"\x99\x19\xf5\xb5\\\x15\\\xf8\x00>\x94\x98\xa4\xa5\x8a\x16\xc1,nrO\xee\xfd\xee\ty\xffx}\x03p\x86\x9d\x12\n\xd82a\xdbE\xddE\x83\xc09炧=\x82\xfa\xa1\x7fO\x1f\xa9\x17Q\x11z\xfdTq\x11v\x8f\x86\x04\x85K9f\x04U\x9f\vv\x9b\xc5#\xa6\x06t\x14i\xa5\xe6ˀFC\xaa\xb8\xc9\xe7b!\x9d\xf0\x84\xe0ė*\xf4BE\xc7\x11\xf3\x82\x88{\x972\x8e\xa9\x18\x1bȅ\n\xb3\x98\x89\xf4\xea9`\x0f)\x97\xa2K\xe0\xc6,Ihh\xa9\xbf\\\x10\xb4\x97x\xfb\xa6\f&J>\x99\xf9\xd6p1\xa0Y\xa2I\xf5\xa5\x15\x04\xa5\xbe\xccT\xc0\xae\xb9\xf1\xdb\xfa\xcbة\x01\x94Q\x84cB\x85\xa0\xf3\x8fW\x114\xbf\xe6\xdfe\"\xe51[\\\xa2\x85\xbc\xfe\x05\xb3r f\x82\x8e\xae\xcez:\xcf\xe9\xddiw\xa6 /\xf6~EǒUwue\x13\xab\xfaԘUi}\xcdR\x1c\x94\xb1\xae\x19\x86U\x99\xb3n\xf4\x8c\xbc\xde\xddv\xe7x\x8a\xdcO؞nڷ\xb0\x83Y\xd9\x02\xe6\xcea\xe6Tņ\xb5\xb8i\xbbk\x9fPK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84BlZ5\xb6\x84\a\x00\x00\xfb\x12\x00\x00=\x00\x00\x00org/gradle/cli/CommandLineParser$KnownOptionParserState.class\xddX[|\x14W\x19\xff\x9fd\x93Y&\x03M\x17\x02\rh;\xb6\x81$\x9bˆ\x00\xad\bFCJ%mH\xd0@b\x8a\xbf\xb6\x93\xdd\xe9f`3\xb3\xce\xcc\x12\xa8\xb7\xd4K[\xb5\xf7b۴\xd8z\xa1E-*\xd4fCH)h5j\xbd?\xf8\xec\xab?\x1f|\xe9\xbb\xfa}3\xb3\x9bMv\xc3&}\xf4\xe5\xccw\xce\xf9n\xe7\xbb\xef\xbe\xf7\x9f\xcb\xef\x00؎\xbfɴ\x8c\xad\xa1Őщc\x12\x8e\xcb\b!\xc5'㼘\xbcX\xbc\xa4y\xf9<\xe3\xdb\x12\x9c0\\\t\x19\x19\xb7\xe1\x84\f\x05\x13\f\x9d\f\xe3T\r\x1e\xc2\x17\x18\xe9\x8b2\xbe\x84/\xf3\xf6+\x12\x1e\x96\xb1\t_\v\xe3\xeb2v\xe2\x1b2\x1e\xc1\xa3|\xf3\x98\x8co\xe2[\x8c\xf8\xed0\x1e\xe7\x93'j\xf0$\x9e\xe2\xe5i\t\xcf\xf0\xf5\xb3|\xfd\x9c\x8cI\x9c\x96\xf0\x1d\x19\x8dH\x85\xf1<\x7f_\xe0\xe5\xc50\xa6\xf8\xfb\x92\x8c\x97q\x86q\xbf+\xe3\xe3xE«\x12\xbe'\xa0Xiװ\xccA\xd76̤\x84\x1f\xd0\xc9@\xc1\tm{MS\xb7{R\x9a\xe3\xe8\x8e@\xacϲ\x93\xb1\xa4\xad%Rz,\x9e2b=\xd6\xf8\xb8f&\xfa\fS?\xa4َn7\x14\xd2\xef\x11\xa8\xf6%\b\xdcz\x1dR\x9f\x86\xb0k\xe2\v\x87%H<\x11\x89\x02B\"\xa9r\\\xcd\xd5%\xfc\x90\xa8}\x15\x06\xf9@\xa0\xbd\xbc\xae\x05\xf8\xac\xea\t-\x95\xe1W\xd6\xf6\x1d\xd3Nh\xb1\x8ck\xa4b}\x86\xe3\xd2ݚA#ijn\xc6&\xc6\rK\xae\xf7\xfa\xfb\x94f&c\xc1\xbb\xbb\x98\x9d;f8\r\x1d\xd7\x7f\xb9\xaf\x01c\xef5L\xc3\xed\x12\x98o*\x8f\xbeJ/\x94\xb7|yC\xafΘ\xcdC\x02\xa1\x1e+Aֺ\x81Q\xfa3㣺}X\x1bM\xd1I\xa4ϊk\xa9!\xcd6x\x1f\x1c\x86\xd8Z\x02\x1b\xef1\xad\t\xd3\xd7j\x917w\x97W\xa04)\xd9V\xb6\xccn;\x99\x19\xd7MW`_S\xb1\xbb\x9aW\x1b*a-\xcf/R\xccN`\x1d\xdb^\xb3\xdd~\xfd\xa4K\xa2\x05:\x9aV-c]Rw\x0fh\u0382\xe6\x95M\xcd\xf7z\x8f!\xcatJg\xabTy!+Pa4\bl(\x88\xcb^W\xb75\xd7\xe2\xc8RҞ3\a\x82<,\n\xaf\"_\a1!\xe1\xac\x00\x04\xfe\xf5\xff\x10\x90屷{A[q\xb2\x83\x97\xed\xbct\U000b20d7\x9d\xbc\xec\xe2\xcc_\x01\x1brѠ\x95\xb1\xe3\xfa]\x06\a\xf6\xc6\"\x9cvv\x93\x82\xbb\xf0)\x05wb?C\xaf\t\xac_p^\xb7mk\xa7\xb8\xb2\xf0\xd5\xeb\n>\x89n\x05;p\x87\x82\x8fb\xb7\x82\x8fa\x8f\x82\xbd\xf8\x84\x82\xc38\"в\xbcN\xb9\xd8\xd9\x7f2\xae\a\xee\xdf\x11\\\xb7\xa5\xe8^\xf5\x8b\xb3ڸ\xd5iT\x13\x96\uea26媮v\\W5S\xcd\xc5x;Uą\x18\x1f\x18=\xa6\xc7I\xb3s\xf8\x11\xab\xf7c\x05?\xc1\x1b\x02{\xbbMU\x1fO\xbb\xa7\xf2d\xea\x84\xe6\xa8i\xdb:a$\xf4\x84\xfa\xa0e\xab\xf1eD\xb7K8\xaf\xe0\xa7\xf8\x99\x82!\fJ\xf8\xb9\x82\v8\xa2\xe0\"\x8eHxS\xe0\xf6\xb2V?h8\x0e\x05\x96\x1f<\xf4\xea\xa0hԕ<g\xb5\x7fA\\\xfb\xad\x0f\xa2\xab\x82\xb70-!\xab`\x06\x97\x14\xcc\xe2\r\t\x97\x15\xcc\xe1m\x05W\xd8\x1f]\a3)נ\x04\xcdsw\xd4\t\xdd\xd6W\xcc\xff\x1d\\\x95pM\xc1/\xd9\x06\xbf»\x8b\xcc\xefg\x90\x82_\xb3\xe5\x7f\x83y\x05\xbf\xc5\xef\x046-\xc5ؗ1R\tݦ\xc2zxLW\x15\xfc\x1e\xef\xf1\xf2\a\x81\x9br\xe2\fGM\xe8i[\x8f\x93M\x12j\x1b!\xfd\x11\xf3\x12\xfe\xa4\xe0\xcf\xcc\xfc/\xcc\xfc\xaf\x18\x10\xb8\xe3\x03\x96^\x81\xce\x15օED7\x968k[U\x81\x11h]Mu\xa0\x16]>\xaf\x05\x1a\xcb\x17\u00a0\x8aP\xa5\xa6\xb5\x9a\xfc<\xaeQ\xe1\xde]\xa2\xe5\x1c\xed[\x9aRԅJt\x92\xbaR튙\xa7t3\xe9\x8ey\xb2z\xa9Y,\x1eI\xe8XK$\x96P\xe7\xc4P\x13Q\xcb\x15`\xeak\xd4~\xbaS)k\"߁\xa8=K\x86\xb3\x9f\x93\xfc\xba\x1c|S\b\x1c]\x81\xbd\x8a\xdb\xf5Jm\\\xa3\xc5\xe3\xba\xe34l\xdf\xd9A%\xbbgŲ\xf2-\xbf\x84\xb1\x97>\xaa\xa8\xeb\xd0 Hv͙\xe8\xbe\x12\xbe)\xdfڊ\x06\x81\xe5\x1a0\xe5\xaec<D2\xb7\xad\x8c\x80\x94#\x9f\r\x05Cl\xa4\xa9\xb9x\x8cݜwj\xaeH\x1587l\x04C\x035\xadE\xc4\x05\xc3D\xa4\xf8\x94\xa2bLsx\xd2!\x85Mﳡ\xa9\xb98\xee\xd8e\x89\xc4\xc28SG\xba\xdc\x19\xd4\x1f\xd2~X\xb3M/u\x17\x13\xe7}\x93\xf7\xf7.\xf6\xf7\xee\x15\f%\x01\x1bÊ\x1d\"\x1e\xee\xb0\xcd\xef\xe3Q[K\xa7u\x93\xb2\xa3\xad\xe4(\xb8L%ݳ\x04\x7f٤]\xc0\x0f\xbbV\xae\x1e\xad/\xa1\n\x19.ͻ\x14yn-\x19c03\x1at\x05z\xade\xf6[AE\x85J?\x18;\xe9\ai5\"<\x06\x10\x14\xe1I\xc0\xfb\xd20\xe0}i\x1e\xf0\xbe4+\xd0̶\x8f\xe0\n\xf4\xd4\xd6\xf2\x80\xe1QV\xf0\xbcA7\ah\xf7<*\xe9\x04h\x8d\xb6L\xa3\"*fP\x19\x9dChd\x06UӨ\x8e\xb6NC\x8a\xb6M#\x1c\xad\x0fMcM\xb4\x9eN\xe5\x8b\x1e\xc7^Zo\x86Dc\xe1S\x90\xc5\x13\xa8\x13Oc\xb3x\x06\xb7\x88g\xb1U<\x87\x16q\x1aw\x13\xceNT1\x7f܃\x83\x80\a\xb1\xde\x15\x1eĚWz\x10\xeb\x1e\xf2 ־\x8a\xb4\xeb\xc7@^G\xc9\xc3\xef\x8ffQ\xf3\x12\xb6\xccA\x19\x89\xac\r]\xc1\xba\x91\xca\xe8[\x90\x06/\xe1\x86\x19\xd4^m\xc9\xe2\xc6\xdcu\xa4\xf8\x9a\xe0\xea\x96Y\xac\xaf\xc001\xdap!\xff\x8a\xcdl\x01\xf1\"$1Eڿ\x8cm\xe2\f\xba\xc4+\xe8\x15\xafz/\xd8\xe8KϿ\xa0\x1f\x87\xf0i\xd2M\xe03\x18\ft\x1c&\xediD\xc6V\x92\x12\u03a2n\n\xf5,o\x16\x1b\x05\xa6\xb0v\x0e\x9bFظ7]X,z\x1d?_\x9c%\xacנ\x8as\x9e8\xc5g\x14\x88\x13<\xd9\x05B:=\xa1 o\xf8B\xce\xe7\xf9T\xf3\xb98_@\x1f\xce\xd3\x0f\xe5\x94\x14\xc7ɐl\xe4\xf7ِSh,\xd0зZ}I\xab\xad\xe1\xfd%l\xf6\xa5n\xe9\xf3\xc9>D\xe3f\x16\x1f\xf6\x80\aBgs/\xbf9\xe7\x81[J{`\x96މ\x83\xad\xb3\xf8\b\x8b\xad#\xe0V\x81k\xb8\xad\xbf\xa5-\x8b\x86s\xff\xfd\xa7\xcff\xeb<:\t\xaa\xb8\x84msh\xa4pl\x8a4g\x11enY\xb4DZ}\x98\x11\tʂh\xdb\xfd}l\x1e\x11\x82\xe4`\x97E\xc7\x05\xde.\x98\xfcv\xac%S\\\xc4z\xf1&\xc5\xe84y:\x8b\xfb\xc95\x13b\x16\xa7\xc5eL\x899\x9c\x11o\xe3uq\x05\x7f\x17W\xf1\x0fq\r\xff\x16\xefz\xa6\x8d\x92\xf9NSt\x0e\x93\xff+\xf10\xb6\xe0\xb3\x18\xf1\xa2\xe3\xfd\xc0\xdc]\x98ƽ8\nQ\vʲ\xfb\x02\xc7\xd9\xe4 \x89]\x13mim\xab\x0f\xd5WͰ\x06\x8b\x9d\xf7\x98'ဏ\x97\x8f5\x05\xf7S\xd6\n\x0fz \xc8\x1b\x05Z\x907\nF\x83\xbcQ\x10\xf7\xf2\x86\xa1\x04t/\xcb\x1f\xf4$$\xe9\xc5\x1c:Ք\x89\x93\xd8\x05\xfe\xd3g\x12]!\xfe\xebi\x12}\x84\xf19\xfa\xa26\x8cM\xf4\xfd*\xf8߭I|?$\xff\x0fPK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\xff\x90|\x10\xd3\x02\x00\x00\xdb\x06\x00\x007\x00\x00\x00org/gradle/cli/CommandLineParser$OptionComparator.class\x95UmO\xd3P\x14~\xee\xd6\xd1Q:\x18oS\xf0\x05D\xcc6\xde\xca\x00A(\"8\xc5,.`2C\xe2\xc72\x9aYҵ\xa4\xed\x88?\xc3\x1f\xc2\x17\xbf\x80\x91\xc4\xf0\xd9_b\xd4\xdf`<\xb7m\xe6`\x93\xe2\x87\xf6\x9e{\xces\x9es\xefyi\xbf\xfd\xfe\xf2\x15@\x01\xdb\x12R\x98\x95\xd0\xcb_=\x98\x131/A\xc0\x82\x84E,\x89x,bYB\x92\xef\x93x\"b\x95\xc3\xd7D\xa8\"\xd6E<e\xe8Z7,\xc3\xdb`\x88\xe7\xf2{\fB\xd1>\xd0\x19\xfaʆ\xa5\xef4\xea\xfb\xba\xf3V\xdb7I3P\xb6\xab\x9a\xb9\xa79\x06߇J\xc1{o\xb8\f\xe9\xdd#ϰ\xad\xa2]?\xd2\x1cͳ\x1d\x06\xb9dY\xbaS45\xd7\xd5\t\xb1X\xb6\x9d\x9aRs\xb4\x03SW\xaa\xa6\xa1\x10\xb6\xaeY\a<\xcc\x1b\xcdqug\xf2*\x87\xca V\xfd\x1d\x05z\x95\xbb\x86 \xf0T\xa3\x11\xf9\x12qھ\\`\x98\x88vh\xc2\x17\xe8\xaeu\x83{\r\x94\x0f\xb5cM15\xab\xa6T<ǰjj`#H6\xd7b\xdc\xdd?ԫ\x9eڮᧈ}\x98\xbfL\x15ڸ\xa5 \x82\xca\x01\x9f.2k\x05\x95Wm\xf2&@\x86\xee\x8aQ\xb34\xaf\xc13Z\xfe\xd7Q\x1b\x9ea*\x7f˰\x1e\x9d\xa5\r\xa2\x96*vé\xea\xdb\x06o\x8bL[\xf89N-#\x8d~\x19ϰ)b\x8ba\xe5\x86-\x11d\xb9\xb5\xb92\x9d\r\x9c?+⹌\"^PW^-\x14\xc3fdȢ\xe6\xea%\xcb\xd5-\xd7\xf0\x8cc\xbd=\xf6X\x04B\xc6\b^2\x8cGe\x8d\xe3F\x19\n\xff=\x17\x97.\x16T\x8ea\xa8S\xe9\xa8ţۂJWӽ \b\rj\x7f.\xdf\xd2\x06\x15\x9d\xb7\xe45w\tX\x18\x86[\xe3\x9b&\x9d)\xa0\x8b\xd3`P\xdas\xe5N\xf6\xce\r\xa7\xe6;\x8dť\xd1\n\xe7\xae]\x93/a\x9e\xbeo\xbd4<}\xf4u\x14\x10\xe3-G\xbb\x01\xda)\xb4\xd2\\!1u\x86\xd8'\x12b\x18\xa4w\x17W\xb2\xef\x18\"Y\x0e\x00\x18\xc6m\xf0\t\xa4\x12\x85\xce\x1f\x89,A\xeb\xf2\xf4)\xe2\xe7\x10ޱ3$>\xa3\xeb\x02\xe2\xceL\x9bjM8G\x92\xef\xbbgG\x84SH'\xcdh\xbd\x88\x13\xf1\x0f\f\xb2\x9fȲ_~ԥ\x809\x8cʥ;\xb8\xeb\x9fd\x19\xf7H\xe2\x9e\x0fq\x1fc䛥\x8b\x8d\x93$\xa4\xb7\xe8t\x0f\xc2ӽ&\v\xf1\"55}\x81\x9e\x19zN!\x9ft\xbc\xe2x\x00l\x06Ka\x82ș/M\x92\x14K\x83\x8fQH\xbcJk,\xcc\x19뜳L\x00h\x12&\x880G\xf68\xf2>~\n\xd3\xfe:\x83[\xb4NP\x1eST\x8a\fe\xf6\x11\xadH'I\xa3Џl\x94~P\nV0\xfa\aPK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84Bသy\xdf\x02\x00\x00n\a\x00\x00?\x00\x00\x00org/gradle/cli/CommandLineParser$UnknownOptionParserState.class\xbdUKO\x13Q\x14\xfe\xee\xb4Ӗ\xe9\x00\xa5@\xc5\x17\"\xa2\xb4C\xa1\x94\x87\x8a \x8aD\xa3\tA\x13\x94\x05\xbbK;\x19G\xa73d:U\xfe\x82\xffŅ$<\x12\x17nM\x8c\xaf\x85\x1b7n\xf4w\x18ϝNJ\x85\x92\xb6.ܜs\xef\xb9\xe7\xfb\xee\xb9\xdf9\x9d~\xf8\xfd\xf6\x1d\x80<n+\x88a\\A\a&:h\x95\x13fR\x98\xbc8\x98R0\x8d\x99(\xaeFq\x8dA.{\xdcӣ\x98c\x88?\xe2nYw\xd7D\x80A}`ۺ\xbbl\xf1rY/3L\xac8\xae\x913\\^\xb4\xf4\\\xc12s\xcbN\xa9\xc4\xed\xe2\x8ai\xebU\xdcH\x1d|\x9e!\xc4]\x83!\xb9\xf2\x8c\xbf\xe09\x8b\xdbFn\xcdsM۠\xa3x\xe1\x10\xcb0|\x94\xd9\xe7)\xd6\xf1\x13$\xb2`ڦ\xb7Ƞ\xa7\x8f36'h\xaf\xf8\xcc:Cx\xd9)Rq\xdd\"e\xb5R\xda\xd4\xdd\xc7|\xd3\xd2ŋ\x9c\x02\xb7ֹk\x8a}\x10\f{OM\x12i\xe0\x89\xfd\xdcv^\xda\x0f\xb7<ӱ\xff\x92\xb3\x85\x12N\x02\xd3\xf3\xbb\fݻ\xcf\xcbK\xaeQ)\xe9\xb6G\xea\xa63\x1b\x14vl\xcap\xbdU}\xdb[\x12rO\xa63\xed\xf6Iq\xecC\xda;\r\xd4m\x9b1\xc6k|DN\xd9[\x96.F\xec\x06\x03\x18^\xfd\x87\x066\xcf\xce\xfbM\x96\xb6'\x85\xc9\v3%\xcc4\xc3H+`zٚSq\v\xfa=S\xb4?u,gB\xbcQE/\xfa\x84\x99Wэ\x84\x8a\x1e$U(\xe8R1\x8c\xc1(\x16T\xdc\xc4b\x14\xb7\x18\xe6\xfey:\x18\xa6\x9ab\x1b\x80z\x1aĲ\xed\x88L?\xdc\xe6B1\x8c\xa6[m\xc6P\xb3!`\xe8\xe4\xc5\xe2\xddm\xcf\xe5\xebܪо\xbfѸ6\xa0:v%\x86\xe8C\xd8A\x1f\xcb\x10\x92\xa2!\xb4J\x8a\x16\xf9\x9e\xbaD>\x02I\xb4\x8f&\xb6\x9fv%څ\xc9\xf7k\xec\x00\x926\xb6\x87\x90\x96\xddCX\x1b߃\xbcC\a\x12RdS\x90i\xc6?Bf\x9f\xa0\xb0\xcf\xe8f_\xd0Ǿ\xe2\x14\x9diU\x02\f\xe0\f\xe0\xafą\xcc_\x89+%\x7f%\x8a\tQ\xf4,\xce\x05Wgɋ,)\xfc\xbavODD\xd87\x9fW\xad\x9e\x06\xbc\f\xe71\x18 s\x01R\xd6\xf6\x11ys\x04\xfc\xbd\x0e,\xd7\xc0\x17H\x99*x\x8e\xbct\x12\xf8\x87\x0fNU\x13j/\x92q\xd1\x7f\x11\x13\xe3\x1d\xd0,R\x8e\xb8\"\xa1\xed\x92Z\xbb\b\xed#JN>dT\x04\v\xfb\x898\xfbUWR\xa2\xcaJ\x1d\xe9\xc5\xe5\x80k\x83\x14$}\x11\xd3Ʋ\xe3\a`;G\xaaz\xef\xe3g\xaa9\xb5\xaab\xb8\x12\xe8\x1c\xc3h\xa0s\fi_g\xb1\xca\xf8\x9d\x910\xe6\xb3e\xd1I~\x98\"q\xccB\r\x8b\x7f\xccY\x9c\xa6*/\x91GB\x8c\xcd,\xae\x87\x95?PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84BI\xfc\x8b\xc5\xfa\x04\x00\x00\x93\v\x00\x00&\x00\x00\x00org/gradle/cli/CommandLineOption.class\x8dV[W\x13W\x18\xdd\x031\x13\x92\x01bT\xc0K\x15T0$bl\xad\x97\n\xd5*\x8a\xa2\\\xac\xa1P\xa5V\x87\xe44\x8c\x1d2\xe9d⥗\x1f\xe2\x8bo\xad/}\xa8]\x05\x17u\xad\xae>\xf9е\xfa'\xfaC\xda\xee3\x19\xc2$3i}H\xce9\xdf\xf9\xce>{\x7f\xdf>+\xf9\xe3\xef_\x7f\x03\xf0.\xbe\x8bc?fU\xcc\xc5с\xd9.\xec\xc3|\x17n\xe1c9\xbb\x9d@\x1e\v\t|\x82E\xf9\xb5\xa4\xe2\xd3\x04\xeeஊe\xb9\xfd\x99\x8a{q\xf4\xd4O}.g\xf7\xe5쁜\xe9\xf2k%\x86B\fE\x19\x141|!C%\x15\xab*\f\x05\xaaUq\f\xab\\U\xd0;\xf3P\x7f\xa4\xe7j\x8ea\xe6\xf2\xc2\x19WЕ7Jeݩ\xd9B\xc1\x91\xe6݉\xfa\xd2\xd4˥\\ޱ\x8dri\xfc\x02Oh\xba]\xaa\xad\x89\xb2\xb3\xf0\xb4\xc2C;}Y\x93\xa6^\xad2ewkl\"#O&\x8a\xa2Z\xb0\r\x97\x8c\x82T\x10^A\xbcZ[)Xkkz\xb9Ȍ\xa2\xa8آ\xa0\xcb\xfc%\xdd.3\x85\x19F\xb9P[aL.\x94\xbb\n\xa2\x13F\xd9p.(\xe8O\xfb\x10\xa7\x1da\xeb+\xa6\x18\x1f]T\x10\x99\xb4\x8aB\xaa7\xcab\xae\xb6\xb6\"\xec\x05\xb9'9X\x05\xdd\\\xd4mC\xae\xbd`\xd4\xf2\x18v\x18G\x1bZܢ\xb8\xa0\x8ee\x93h\xc4Y5X\xd0\xc33\x96]ʕl\xbdh\x8a\\\xc14r\x93u\xf2\xf2\xa6y\x17\xa6\xa5\x1c\r^\n\xfa\x9a/g5=\x02a:\xda4#\xfbֹ\xb2\x0e\xf1\x92p淼\xb03=\x1ap\xc3pk\xac͵\x89U\xbdzɳ\x81\x82\xa3<\xf5\x16e\xd0|\x87x\x7f7\xc9\xe4}\xdd\u07bduw\xb3#\x92kz\xa5\xba`\xf93Ϥ\x83\x89o\xc5@m@\xf4\xf0\xf2+~7FmQ\xad\x99\x14\xb37\x80}\xb9f\x98E!\x9b\xdeC\x05M\xa7R\x84\xb9d\x9a\xd6c\xbf\xb0\xce\xf4(m\xb9\xaf\xb15K\\\xa3b\n_J|\xcbق\\\xf6\xb8\\B\x9c\x9e\xb7jvAL\x19\xd2\x13}\x01='$O\r\xa3x\xc8^n7\xed\xba^]e\xe34\x1c\xc0;*\xbe\xd4`bH\xc3 \x86T\xaci(\xc3RQ\xd1\xf0\x15\xaei\xb0A*\xc9V\xb9*x\xb8\x86G\x94\xbb\x8d:cT\x19\x1d\x06\x1fD\x7f\x9b\xfah8\x82\xa3\x1a\x1e㉆\x119{\x8a\xaf\xe5\xf2\x1b\x02-o\xeb\x1d\x1c\x1b份\xa7\xe1\x18\xd2\xf4\xc4\xf2\xf6sf\xec[\xf0\x1d\x0f\xfe_'\x9bHϯ<\x14\x05ǭ\xfa\xe2\x16ewc\xd12X\xdc\xc8\u009d[W٨\xe0+Q\x103\xbc\xf7\xcc\xf26\x19\xdf\xf7\xceS\xc1(}D\x1f̉'\xbc5Rv\x87f\xef\xd6)\xf1pw\xd3[\"G\xbd(\xfb\x9d\x0e\xe6J\xc7D\xf5JEHs\x8e\x85\xfa\xbb\xbd-\xa3\xa6(\x97\x9cU\xb7\x06\xd3\n\x0e\xa4'\xff+;\xe6X\xf5\x10\x86\xf8c\xb1\x9f\xbfLQ\xa4\xa4[\xa0\xe0 W\x1d8\xc4\x0f\r\xd3X\x1f懽u\xe7\xc3\xde8\xe2\x8d\xec\"\xc7n\xe6\x8e\"\xc3u\x96\xab\x97\xe8D\x84\xe3\xc5\xcc+(\x99\xd7\xe8\xb8\xf3\n\x9d\xeb\x88d~ƎuD\xb3\x9bP\x15\xcc\x1e\xdfDL\xc13\xec\xe7\xa4K\xc1\xef\x88\xcfe~Adl\x13\x89\x0e,\xbd\xf8篗\x84\xe8\xc4q~\x1f\x84\xea~GЏ^\f\xa0\x8f\x14O\x92\xe28I^ \xbd1\xeef\xb8{\x12\t\x9c \xb1N\x8a\x19B\x8e\xeb\x0eI\x83?\xbd\xef\x01\xee\xec\x00N\x91\xea\xfb\x9ck\x1c\xeb\x91\xd3\r\xa1g8;\x8bs\x9e\x8c\x1cW2g\x87\xa4\xf5\x93\x9bpܭ\x96\f\x0e\xbb\x97\xd6Avx\x17\xd4A>`\xec<\xa9).\xc8YFdNWf\x17\xe2\x14\x9f\xd9\x06\x8a\xbb\xecF\xa9-\xe3\x03\xeb\xf2\xc0\x14L\x84\x82ha '\b\x92\v\x05\xf9\x90\x05R\x02r\xba[\xe5\x9c\n\x91\xa3\xb0:\x1fy\x87/2[^\xa4f\xb2\xeb\xe8\x0e\xdc\x7f\x96\x87ι\x10}\xf5\xb4F\xc9U\\bC\xe4͗\x1bL\xfe\xf4\xc0\xc4k\xf4\xd0\x1a\xbd3d\x94|\x03-+\xc7\r\xec\\\xe2\x98z\x83tv\x03\xbb\x9e#\x9eM\x0en`\xf7R6\xb5G\xeeɤ\x94;I\xf5y\xb9\xfd\xcf0К;\xe0\xa6l`\xef6\xd5\xf3\xfcO\x04V5Ƣ\xf4\x92\xcc\x00\xd5\x1d\"\xbd\x11R\x1b\xc3\x15\xba\xe2*w\xa68\xbb\x8e\x9b\x98\xc6m\xdc\xe0?\xb0\x19<\xc0\x9cO\x9a\xf0\xa4\xc5p\x0f\x93̕Ү\x86\xd7)\x19\xa8\xd3m\xd6)ߦNG\xbc:M\xe1\x9a\av\xdek{\x92\x1a\xa3|;\xdfC\x8d\xbc@\xa4\xf3ǖ\xe6-\xf9\x9a\x97l4\xefz8\x0e\x1d\xf4C\x1b\x9c\xe5P\x9c\xe9pq\xa9\x80\xb8\xfb\x14\xf7\xa0\x8d\xb8\x11Oܱ\x86\xa7O{\xa4\xd4Ld\x1d\xfd\x01\xb0\"\xc1\x84\x8f\x8f\xda\xe0s#\xd4ѩVG\x1b\xa1\x8e\xbe\xe9f\xcd\xfc\vPK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\xfa\xd6\xd0>\xa6\x01\x00\x00\xa6\x03\x00\x008\x00\x00\x00org/gradle/cli/CommandLineParser$OptionParserState.class\x95R]K\x1bA\x14=\xb3Y\xb3vMM\xb4\x9a\xe2w\x8c)F)n\x14_$\"h@\x14\x82\n\x91<\xf86I\x86\xed\xc8fVf'%O\xfdO}*\xf4\xc1\x1f\xd0\x1fU\xbc\xb3\t\x88XH\xb3\xf3p\xee\xdc=\xf7\\\xee\xb9\xf3\xe7\xef\xefg\x00\x87\xf8\xe2#\x83M\x1f.6=\x94<l3dO\xa5\x92\xe6\x8c!S\xddk3\xb8\x8d\xb8'\x18\xf2M\xa9\xc4͠\xdf\x11\xfa\x9ew\"\xca,6\xe3.\x8f\xda\\K{\x1f']\xf3M&\f\v\xb7OF\xc6\xea\x8e\xebD\xe8\x96\xe1\x86~宕\x12\xba\x11\xf1$\x11D9n\xc6:\fB\xcd{\x91\b\xba\x91\f\x1aq\xbf\xcfU\xcf\xf6\x19\xd5Uމ\xd4\x19\xe6cE\xa167bh\xceu\xe8\xa1\xcc0\xf7\xa6O\xad\xba7Y\xfa\xad\xa8\x1f+\xd2\x1a\xf4\x852\f\x17\xd5\xe6#\xff\u0383\x88\xab0h\x19-UX\x9fZq>\x14\xe6\x8a'\xaf\xaad\xe6Cڈ*\x9f\"a\x84\x87\x1d\x060\xecV'k\x1f\xd6\xed&\x9ca\x8d\xa1\xf2?ljԊ\a\xba+.\xa5]J\xf1\x1d\xe7\xc0N\x98\xc3\f\xb2\x1e*\fGӯ\x82\xa1\xf0\xea\xd2m\xe7QtiȯӸ\xc4P\x9e<\nCi\x12\xc9-\xd1\x13va\xbf,\x1c;\x13\xb9\xea\xd1- $\x8b1\xb3\xff\v\xceO\n\x1c̦$\xeb\xfb\x0f|\xa087\"\xc0\xc7G\xc0\xa5\xb5a!\xc5E|Jq\t\xcb)\x16m\xbe`\x99+c\xf1\x13Bg,\xce\xfe-^\x1c\x11F\xe2i\xb4\x8a\xb5\xb4l=\xe5oP{P.Cg\vs\xae\x8f<a\x81\xf03!\n\xb3/PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\x83\x94\x86\xb5\xf4\x06\x00\x00\x16\x10\x00\x00&\x00\x00\x00org/gradle/cli/ParsedCommandLine.class\x95Wkw\x13\xd7\x15ݣ\xd7\xc8\xe3\xc1\xc6\xc2\b\xe4\x04\xa3\x10\x83e!p\x1e\xb4%\xb6cb\bԀmRLq\fM\xd2\xc1\x1a䡲F\x19\x8d\bN\x9bg\xdfͳI\xfa }\xe4A[\xb7]|H\xb2\x02\x94\xb2\xda\xe4[\xb3\xf2\x1b\xf2-_\xf2+J\xf6\xbd3\x92%k\x1c\x9c\xe5\xb5\xe6\x9e{\xee\xb9瞽\xef9\xe7ʟ\xfe\xff\xdf\xff\x05p7\xfe\xa9!\x89\x82\x8ay\r!\x14\xda0\x00K\xc59\rQo\xf2\x03\x15E\rqo\xb2Ў\x12\xecv\x94\xf1\xb8\xf88***\\\r\x9d(h\xe8@\xb5\x1d\xe7a\xabx\xa2\x1d\x17\xb0\x18Ǔ*~\xa8qۏ4l\xc0S*\x9e\u0590B!\x8eg\xc4\xf8l\x1cω\xf1ya\xf1c\xb1\xf3'\xed\xf8)l1\xfd\x99p\xf0s\x15\xbf\x88\xe3\x97\x1a\xfa\xf0\xabv\xbc\x80\x17\x85\xcdK*^V\xd0i\x97]\xcb.U\xf6/N\xbb\x8eU*P3q\xce8o\fV]\xab88i\x94\x87\x15\xb4M[\x85\x92\xe1V\x1dS\xc1\xd1\xe6\xd5\x11oZ4J\x85A\xcf\xc1\xf0\x84\xed\x14\x06\v\x8e\x91/\x9a\x83sEk\xf0!é\x98\xf9\x03\xf6\u0082Q\xcaOX%\xf3\x98<qx\x94\x9e;ʎY1K\xae\xa7\xaa4\x1f>m\xba4\xb9\xb3Y\x13p\xa0td^p\x1dc\xcc)T\x17莎\xd67l\x9b\xb0*\xc2S\xdf\n\xd5*\xaeb#V\xc9rG\x15l\xca4\x18\x1cvM\xc78S4\x87\aN*\x88\x1c\xb0\xf3\xa6\b\x96h\xa6\xaa\vgL\xe7\x84XS\x90\x98\xb0\xe7\x8c\xe2Iñ\xc4\xdcW\xb6y\x14\xf3\ba\xd0r\xa6\x82\x90է\xa0\xbb!:y\x98k;\\\xd3˒>\x8f \x05\x99\xb5\xb2K\x1c\xb6\xbfg\xdb\xca=A\xd6\x11wު\x04ض\xf8\xa7\xadj\u05ee\xab;\x88!\x05\xc9f\x1a\x16\xcb5*\xf6\x06؏\xdc:<q-\xc3A\xb7\xb1\xa6\xbd\xe2\xc6\xe2\xae]\xcb\xef\xee\xcc@\xd0%\xe8\x8fWm\xd7\x1c+\xe5\x8fؖ :\xf8\xf2\x83v\xc6*\xbeg\xd5\x13*\x82\xfb\xaa[\xae\xba\nR-\x1b\xf6W\xadb\xde\x14W\xabZ\x95C\x96S\xa1\x95rJ\xdcl\x10\xbc\xc0\f\x1dY\xb3m`\xbcm\xf3F\xa5\x96O\x1b3\xad\x16\x03\x8cF\xa7\xcdXi\xb1f\x96\xca4d\xe7\x01\xbbX4\xe7$\xb9´\xb3h\x17&\xcc\xf3f\xb1^\xc4\xc9`c\x05\xd9\xc0\x85UP\xee\n>3\x18\xe7\xa9\x15\xacԠ\xac\xbd\\\xba\n\xa6{pE\x13I\xd4R\xa5\xb1\x8d\xechQ\xae\x02`\x9d\x91\xcfK\x8f'\x8db\xd5\\\x85k\xe6f\xf4\xbc\xb7\xdeF\xfb\x1a\xe1\x8f\x06\xd8\xde:\u05ff\x06\\mڮ:s\xe6!K\x94e\xb2\xc5n\xb78]\xc7\x1e\xbcBf\x96ю\x1b\x95yv}\x1dY\xeclY`wֱ\x1b\x83\n6,/\x8c9\x8e\xb1(H\xd2\xf9@ޣ\xe2U\x1d\xbf\xc6k*^\xd7\xf1\x06~\xa3\xe3\xb7\xf8\x9d\x82\xf4\xad\x90\x91\xf5\xb5!\xd3\xf1{\\T\xf1&\x1f\x80\x95\xfc\xa9\xf8\x83\x8e?\xe2O\n\xb6\xfa\xfdk(\xbd\xbd\x92K7\xbf\x1cB״\xf9ؙsL<\x1dGpTǟ\xf1\x16߅UJ\x9a\x8d<\x97\xd6\xf16\xdeaE\xf7\xeb\x18\xc7a\x1d\xfb\xf0=\x1d\xef⒊\xbf\xe88\x81\xef\xea\xf8+\xfe\xc6>\xdbP\xc0\xcc\xec\x82Q\xacEp\xf0\u009c\xe9c\xde\xecaJ\xf7o\xaf\xf4\xa7K\xb6\x9bΛg\x894\xbf[\\\x8c\xa1bI\xc7\xdfqI\xc7?0\xd3\xcaa\vA\n\xc2\x19\x91n\x89\xd6\xde\xc1\xfeh\xf9O\x0e\x93\xa1)\xbf\x1b\x9e\xa2D\xab\x96M\x8c\x8db\x8a\x14J\xef\xac\xc2HIN\x9a\x9b\xacǡ\xc8:\x16Y\xbdMt5\x1d\xe4\xbd\xf6\xeb\x9a\x14Ms\xe6\x1d\x0f\x91]uo\xa6\xd5y\xab&0\x82\xd8Y\xdbY0\xe8㾀\n;\xfd\xd5N\x96;\xbeQ.\x9b\xa5\xfcr\x8fj\xee9\xab\xb7\xfc\xf8\x9c]r\rK\xa0\xdf\x18\x80A\x10\xd8\x1d\xd4\xf4\b\xbc \xe8\xd8\x11\xb4)\bfGs\x87\xe2~6\x17\x11x>?V,\xe2\x0e\xfe2L\xf27k\x18\tQ\xcaP\x90\xe3,\x84]\x9c\xb3\x82\xeb\xf3\xbb8g\xd9\xd6\xe7\xf7\xa2\x8d\xe3\x1e|\x83\xf27\x01\xa5\x97\x1eTj\x9e\xce^\x83\x92\xbd\x81\xd0\xec5\x84\xaf B1J1v\x05*\xc58Ŷ+\xd0v^G\xbb\x82\xc9\xdcu\xe8\n.\xe2(\x85u\n>F\xc7\xd4\rt\xd2f\xfdPd\xd7Ut]GB\xc1P4\x15\xf5\xed\xd2B\x92\x86\x1b\x86b\xd9\x0f\x11I\xc5R\x91\xeb\xe8\x0ecf\xe9\xe6gK7?x_\"\xf9\x16\xbf\xbb\xd1\xceo\n\x11l\xe4/\xe9$z\xb0\t\xdb\xf9݇\xdbX\x8e\xb7\xc3\xc2\x16<\x81^<\x89\xadx\ni\xec\xa5\xf58\x11X\xfc\xc9}\x1f\x86\x10\xc34\xfa1\x8c\x11\xfef\x1f'\xee\xfb1J_\xfb\xa8ه\axF\x0eߑ\xab!\x81\x19c\xd8\x0fH\xe9\x00\x1e$G\a)\xeb\x1c=͡:kߦ\xc4f\xc0/9c#\x89I\x9btbc\xf4?HΆ\xb3Ĥ^æ\xe9و\x105!\xfe\v\x9bߓ{\x05*ϾOF\xeb\xf9O\xfbg\x87D[\xf2\xee\x02_p\x8cq<~\x03)\x92\xd93\x19\x19\xf5\b\x1f\x8a\b\xba$\x95{\x84\xe4S\x19\xdd\xf2&\xb4\\ⶫ\xb8}&\x97\xd8\"\x87T\xb4a\x16\x1e]\xba\xf9I\xee*z\xdf[\xc1o\x86\xff\xc4\f@c\xe6d\x98;9\xfe\xdd˕!\xe6\xcd\x183f\x9c\x19s\x84\xdcMR+\"\x1eeT\x19l\xc3\x04-\xa2̧a\xc9`DDZg\xf08\xad\x05\x83q\xee\x9c\xc21\"\xd1\xe8\xe7!\xb2\x1dn`UX\x1d\xaf\xb3:M\x89\r\xd5g\xf505\xe2V\xba\xb2;\xafb댠\x94\xe8\xd3!\\\xaeӨI\x83\xfb\x19Ψ\f,\xe9m\xa8\a\xd1\xc5K\x1e\xa2;\x05'1\xe3\x93\xfa:G\x11\xea\x00\x9d\xddѐ\xbb=\xb5\xdc\xdd0\x95e\xcen\xbb\x88h\xe4\xf2\xd2\xcd\xcf×\xeb\\%\x89VdW\x0fc\xdbF\\}d\xa0\x9f\b\xc4\xd1Y:\xeda@\xe2@QA\xe9zV\r\xd4\xc3\x19\xc0Øm\xca*\xa19U\xc7\x7f\x9a\x12_\x17\x1f\x7f\x85^\xc2\xc2F\x14\b\x83\xbd3\xc4\xe0:'s\xff\xc3\xe6\x1b\xe8\x9bMl\x8f\xc8\\\xdb)2\xeb\x1av|\x94[N\xaf\x84D8\xc3\x12x\x98\xf2,\x83<-\x83L{\x0e\x1b\x02\xf2\xf8\xe9`1\x89\xc2\bQ~\x04\x8f\xfaL\rJ怨Hᕹ\xfbXC\xeeF}\x87\x1e\x8a\xc7\xf8\xfd>\f\x1fŃ\xfe-\xea\xc2\tQ\xf4\x870\xf3\xfe\x8a\v\x9cc\x0e\xe6\x1b.P\xaf\a\xa8\xe3\x8c\fP\xd8\xe4}\x8f%\xff\x02{\x9bx\x99\x12\xf9\x91\x93\xbd&\xc3#v-\xc7\xdb!A\x178\xce\xcb~Q\xbb-z\xa8\x9f\xd3+{\x85\"%\xd1\x17B\xb4^/)\tSkJ_g\xbf\x04PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\x8bA5l|\x01\x00\x00\v\x03\x00\x00:\x00\x00\x00org/gradle/cli/ProjectPropertiesCommandLineConverter.class\x9d\x92KO\xc2@\x14\x85\xcf\x05\x04D|?Pâ\xec\xc0\x04\x1a\x13u\xe3#Q\x8c+\xa2$\x18\xf7C;\x961m\x87\f\x03\t\xffJW&.\xfc\x01\xfe(\xe3\x14\xaa1\xd8D\xe3,\xee\xe9\x9c9\xdf\xf4v\xa6o\xef/\xaf\x00\xf6\xb1[@\x16\x1byl汕G)\x87\xed\x1cv\b\xd9\x13\x11\n}FHWkw\x84LS\xba\x9c\xb0\xdc\x12!\xbf\x1e\x06]\xaenY\xd77\xceZK:̿cJD\xf3\xd8\xcc\xe8\x9e\x18\x10\x8eZRy\xb6\xa7\x98\xebs\xdb\xf1\x85\xddV\xf2\x81;\xdaH\x9f+-\xf8\xa0)\x83\x80\x85n\xb4kS\x86#cruLX\xf5\xf8gh|\xd3\xd7B\x86\x84\x8dj\xad\xf5\xc0F\xcc\xf6Y\xe8\xd9\x1d\xadD\xe8\x99\xe8Ώ\xe8%\xd7L\xf8\xdc%\x94\x13\xd6\x06\x8e\x12\U0004e14e\x1c*\x87_\x89\xa8\xe5\xbd?\xf5ֈ:(\"\x87<\x81ڄb\x7fJՍ\xf6\t\x17\x1d\xae\xad؊t\xf2f\xeb^*K\xf7\xb8\xd5\x1d\nߵ\xa6\rXU\xde\xf0\x1aV\xbd\x1d\x8c\xa3\xdci0\x1e1\x7f\xc8k\r\xc2\xc1\x7f\x0e\x8dp8\x83\x9dw\aZ\xb1\xdf8T0g\xee?\x1a\x19P\xf4a\xa6Λ\x99m\x94\x8c\xce\xed=\x83\x9e\xccC\n\x05S\xb3\x13s\x1d\v\xa6\x16\xa7\x01\xa3\x8b\x13|\t\xcb1\\\x8f\xe1\xf4Z\xeaq\x06-}C\xd3_\xe8J\x02\x9a\x9eEˉ\xe8j\x02\x9a\x99E+\t\xa8\xf9w'\xa9\xf5\x0fPK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B1\f\xa9\xabP\x02\x00\x00\x04\x05\x00\x00F\x00\x00\x00org/gradle/cli/CommandLineParser$CaseInsensitiveStringComparator.class\xa5S]o\x12A\x14=\xb3|l\x8b\x8bE\xda\xe2g\x15+Z\xbe\xec\x96ꋁ\x10\x95Ą\x94\xa8\tM\xdf\a\x18\xd6i\x96\xddfwi\xfc+\xfe\a^|\xa9\x89\x0f\xc6g\x7f\x8dQ\xe3\xa3\xf1β\xc1\xb4`01\x84\xb9w\xee\x9c{\xee\xdcsg\xbf\xfc\xfa\xf8\t@\r\x8fS\xd0\xf1 \x85\x15\xb5$\xb0\xa3\x96\xa2\x8e\x92\n\x97uTu<ԱːlHG\x06M\x86X\xb1t\xc4\x10o\xb9\x03\xc1\xb0֑\x8ex9\x1e\xf5\x84w\xc8{6E\xb2\x1d\xb7\xcf\xed#\xeeI\xb5\x8f\x82\xf1\xe0\x8d\xf4\x19\ued38/ڎ/\x1c_\x06\xf2Tt\x03O:V\xcb\x1d\x9dp\x8f\a\xae\xc7`\xb4\x1dGx-\x9b\xfb\xbe\xa0\x84\xe7\x1d׳L\xcb\xe3\x03[\x98}[\x9a\x84\x1dqg\xa0\xaa\xbe\xe6\x9e/\xbc\xc2\x12\xca:\x83\xde\x0fwt\x8d\x9db瘟r\xd3\xe6\x8eeN\x91\xf5\xf9H\xa9M9\xeeI ]\xa7\xa6\xfa\x99\x03̎\xf7\xa9\xb3\x81\x1c\x0e\x19X\xfb\x02\xfb\xabޱ\xe8\a\xf5\xf9\x88b\xd7\xde\xee\x9d'\x8e\xce\xd4IM\x87ɀ\x90ni\U000f5e9aE\xe1_\x80\f\xab]i9<\x18+%\x1a\x7f\xbb\xea8\x90\xb6\xf9G\xbe\xc6|\xf7M\xa2Juݱ\xd7\x17/\xa4\x1ann\xaeܮJ2\x90\xc2%\x03{\xa8\x19\xd8\a)\x99\xb9He`\x1d\x1b:\x1e1<\xfd\xdf1\x9fc\x9f6İ\xb1\xa8#\x86\xed\xe5j1\xacG\x8f\xe6\xd0m[\x8e\xeb\tU\x9fas\xc1\x03R\x03]\x9d\xa1\x19\xf2\xcb\xe8I\x12\x9d>7ʢ\x0f0\x0eM\xe9D;\x83v&Y\x1a>\x12\xe5\x0f\xd0ޓ\xa3!MkR\x05\xd9W\\&ߘ\x02\xb0\x86,\xd43!\t\xa3\xe4!\xa1\xe3d\xb3\x95\xea\x19bͭwHlM\x94\x1f\x9f̘\xb2\n\xc1\xbe!ɾ#\xc5~\xc0`?C\xd6\xf243bU\xde&ra\xa5,\xae\x92\xa7\xd1\x1d\f\\\xc3u\xc42Ϩ捨\xe6\x01b\xf4\x03\xd2\xe5\xcag$\xaa\xf4?Cr\xb2\xf0\xe2\xf9)pV\"\x8d\x9b\xb8\x15\x96Hc\x8b<-\x03R\"\x1f\x11?!\xabEJ\xb0\xc5J䦀\x19a\x82\b\xef\xd2y\f\xdb!\xfe\x1e\n\xa1\xbd\x8f+a\x1f\x1a\xc9^A\x86\xee~\x9b,2+\xbf\x01PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\xa2V\x98\"\x97\x10\x00\x00\xdc&\x00\x00&\x00\x00\x00org/gradle/cli/CommandLineParser.class\x9dZ{`Tՙ\xff}\xc9\xccܙ\x9b\v\x84@\x90A\x81(\xa1$!!\x80\x12!\x84G\b\xa8(\tjD\f\xe0\xe3&s\x13\x06&3q\xee\x04\x88\xf5٪U\xb4\xd5>\xa4\x05\xdb\xfa\x86\xb6\xab\xbb\xd5Jb\x8cϮU\xb7k\xd7\xddխ\xdd\xeen\x1f\xeb\xf6\xb1\xab\xb6k\xb7\xbbݶ\xbb\xb2\xbfs\xef\xccd\x1e\x17\x13\xf7\x8f\x9cs\xee9\xdf\xf9^\xe7\xfb~\xe7;\x03\xdf\xfd\xe0\xe9\xe7\x00,\x93\xa3!D\xf1K\xd5\xfc\xabj\xfeM5\xefhx7\x84\xf7\xf0+\x1d~\xfcZ\xe7̿\xeb؆\xf75\xfcF\x87\xae\xfa\xff\xd0a\xa8\xc9\xdf\xe2?\xd5\xf2\x7fi\xf8\x9d\x86\xff.\xc3\xef\xf1\a\x1d\xd3\xf1G\x1d\xe5\xf8\x1f\r\xff\xab\xa3\x12\x1f\x94ᄠLDJTS\xaa\x89OǍ\xe2\x0fJ@\xc7\x1c\xd14\t\xea\x98'\xa1\xa0\xe8\xea\xbbL5\x86\xa2\x98\xa2c\x9fL\r\xca45S\xae\x9a\xe9j\xa6\"(3\xa8\xa5\xcc\fJ\xa5&\xb3t\xd4\xe3}J\x96S4\x99\xad\xa3\x11\xef\a%\xcc^\xe6\xa8\xe6T\xb5\xe34\xd5\xccU\f\xe6)\xbe\xf3US\xa5\xc9\xe9:V\xc9\x19\x9a,ФZG\x8b,ԱZ>V&\x8b\xa4F\x93Z\x1d\xebɗ\x1fФN\x93\xc5:6*\x8a6\xa9פAǹjq\x894\x06e)= \xcb\xd4\xca\xf2 bJ\x933Us\x96.+\xa4I\x8d\xce\xd6de\x19.\x96UAi\xd6d\xb5\x1a\xb6(\xd1k\x82\xb2V\xe9\xb8.(\xeb\xd5w\xab&\x1bt\xecR\x8cۄ~\xde%\x1b\xe9ve\xc2.9U\x93MA9G\xd9p\xae\x8en9O\x8d6\a\xe5|ʥ\xa7\xe5\x02]\xb6H\xbb&\x1d\x02\b\x8c\xcd\xf1\xb8\x95l\x8b\x99\xb6mقY[\aR\xd1D\xbc3\x95\x8c\xc6\xfb\xda\x12\xfd\x03f\xd2L%\x92\x9al\x15\xcco3mksܶ\xe2v4\x15\xddg\x15\x12\t\xca\xddݹ\xfb.\x14\xcc\xde\x16\xdf\x1bO쏻\x8b\x17\x9aI\xdbJv\xa6̔\xa5\xc9E\x94x\xc1I\xd6.\x16L\xf7\x98\xee\x14T\xb6Gm\x9b\xa2\xdd\xd5\xd6d\x9f\xb3D[Z{SVҝ\xb55\xb9D0ә9'\x9a\xb4S\x9d\x83\xddԫߌG\xc8`\x83՛HZ\x05\xf3\x9al\xcb:\xa0u\xbf\x99\xb4\xf2\xe4^\xca\xc0̛\xd8N\x81\xb9\xde\x12LK\xb8\xa27\feg\xb6\xec1\xf7\x99\x8d\x83\xa9h\xac\xb1\xdd\x1cX-\buF\xfb\xe2fj0I}7寶\xb8\x9f13\xde\xd7\xe82X\xbd%\x91\xeck\xecK\x9a\x91\x98\xd5\xd8\x13\x8b6\xa65\xdd\x12\x8d[\xae\xe8\xd5k\xc9s\xba\x19\x8b%\xf6\xb7G\x0fX\x91\xb4\xf1\x02\xd9!\x98\xe1\xcc\xe7y\x9f+\x15\x11k i\xf5\x98\x8eg)\x85\x0e\xa2G\\\xd9\xd1D\xa33\xb5=\x19\xe54YϨV\x81\x91t\xb6n\x8c\xdafw̢\xff\x02-\xd1x4\xb5VPZSK\xbf\xf8\xda\x12\x11K\x19K\xb5:\x06\xfb\xbb\xad\xe4%\x8a\x90\xa2\xb6$z\xccإf2\xaa\xbeӓ\xbe\xd4\xee(\xd58\xe3Cls\xfd\xac\xc4\xd7d\xf5J\xab\xa4\xe4\x95\x17N\n\xfc\x03j\x8b\xe0욝\xc5^\xac-\x14\xe5\xf0\x8f\xe4\b$\x87\xb2\x9e\xf1O\n\xf6`\xc3D\xdbt\xa0\xc7ʄ\xd7e\x82\x9559T\x9b\xa9\x89\xb2pr\xe2\fG\xe1\xf4y\tΚ\xd0\x1b\xd5E\xb9@.\x01+\x1ea\xfc\xf3\xb873\xb4\x12\x99hT\x9e\xf7P_\xa2D(\x97h\x19\xf7&ҲKMš$Z\xcd|ɉH\xc7\x1e\xe6\xf0\xea\xect\xbe\x99\x8c\xbb\x81B\xc3<\x8e\xd5\xcbx\xdd٘\xce\xda%\x13\x9b\x9eo\xf4\xac\xfc\xa8\x1a\x1a\xc8D\x96\xd7ix$\x95J\x99-\x93\xa6\x9d\xd4i\xce\x1f\xcf@\xe2I:\x92\xec\xd6\xf8x>V\xd7\x141\xf2\nx}@\xa5\xdf6\xdb\xec\xa3=\xe1\\%[\a\x06x\xd8n\x801\a\x8cD\x1e\xf4\xccL$#V2\x13O\ueb1d\x8f?\x9dV\x8a\x02\xa61\xf7{\x1d=34\xe594[\xa2\xb6\"*\xddk\r1\xa7\xf6\x99\xb1A\xaf#-F!\x12[\xf1TRm\xda\xe4\xf6\x95\xf9\xd8V\xedL+։\xc1\x94:BO\xc3\x18\xc3\xc4\xe5~3\x95\x8bI\x0e\x8bs2Ӥ\x99\x9ag+-\xf0Ǩ\t\xfb\xd2~\xf3\x80`A\xbe\xcd'\t\x80\xea\x02\xabOB\xb6\xda\xcb\f/\xa0\xf6\xdc}f\xa1*\x93\x82\xf3\x15\x13_\v\x9e\xd2|{\x12Q\xa6sKM\xce\xfe\xb6D,f\xf58\xac\xbdp\xd1\x03$\xfc\x84g\x15Q\xb9\b\xb2\xb5{\x0f\x99(\xbcq\x16\xed\xec\x01\x16\xca\xe0\x01\xdaV\xb6\x1c\bt\x0f\xf6\xf6\xaa\x93\x9c]$h\x83\xb3\xa2\xc4\xf5\xaaK\x98\xb1\xeeɰ\xa5N\x19\xb6\xce\xdb\"\xb58I\xa3&w-xŵ\xcfL&\t\x8c\xbe\x98\x15\xaf\u03a2'\x1d\xa0\x9b==\x96mW\xafX\xbaT\xb0\xb0f\xe2ܮ\xe5\x85\\r`\xe9\xf8Εj癓\xd9YTDdX\xacR,\xca\xd2\x1f\xcb\x1cUVM\x9ea\xd1e\x1fl!\xbd{\xb1띉\xc1d\x0f+$\x85\xab\xb3\x8aX,Q\f\f܁;\r\x1c\xc4\xed\x06n\xc6-\x06n\xc3\xed\x829\x19\xde[\aS\x03\x83)\xfa\xda2\xfb]\x11\x9at\x19xQv\x18\xf8\x8c\xecd\x83{Ts\x17/\x92q\x03\xcf3\xed\xdd4\x927\xb0\x87\x8e\x9a\xec2\xe4r\xb9\xc2\xc0\x17\xf1\x15A\xd5D\xc8\\ęI\xa8ɕ\x86\\%\xa6R\xa2[5=\x82\xa6\to ςQ\xedf\xd5h\x19\xd2+}\x9a\xec6x\xc1\xee1d\xafĈ\xaa\x85\xe1fH\xbf0;K\x1a\x1a\fI\b\rl\x98Phn9\xabd]\xcd\x00lh\xd8yŚ\xcb\x17\x1b\x92\x94\xb8!\xb6\xa4\f\x19\x94}\x86\xec\x17B\x9f\x9e^]\xb3\xa4ΐ!\xb9F\x11|ܐk\xe5:\x1e\xae\xb3\xa4V\xf0iuN\x1a\xbf\x1b._R\xc7\xec\xcc\xc1\xe2L\xb1\xb7)\x99L$\r\xb9^)zJq\xf2Fc\x11\x95\xd7B[n\x90\x1b\r\xb9I>a\xc8'\x95\xf17\xcb\x01Cn\x91[\r\xf9\x94\\g\xc8m\xeaxgx\xe0\xb8:\xf9\xe3y\xe7sIҲx>\x82e\x93,\x85Ɵ\x19\xca9\xb7\xab\xe6\xa0&w\x18r\xa7|:\xa3u\x1a\xdf\xe3{\xadH6\xb2\næ(\xed\x89\x17\x93T\xa1\xf0\td\xc8g$\xdf\xe0\xd6d\xd2\x1cR\x17\x8c&w\x1b\xf2Y\xe5ϒ\xfa*\x03\xdf\xc3_\x19\xf29\xe5\xb6\xcf\xcb\x17\f\xb9G\xee\xd2䐁W䋆|\xa9\x80G\xf6\xe21䰊-\xdfB{a</\xc4\\\x806\xe4\x88\xc4\f\xb9W\xbeL!\v\x9d\xb3a\x96hvU\x95\xda`\xc8W\xd5a\xcc\xf2\x86bM\xeeS\xf4\xf7\xf3\xba\xcf)\x8c\b\xb5}f\x8c\x15\xe6`?\xef\xf7l\xf5˂ǵ\xbfj\xd1B{QUԮ2cL\xf3\xc8PU\x84\xc5E܊,QZ<\xa0N\xe4AC\x1eRq\xdf\xdcf\xc6\xe3\x89T\x95\x19\x89T%r\xf6\x9a\xdc\x1b\xcf\xcc\xf4\xb84v\xcaL\xa6\xaa\xf6GS\xbb\xab\x165,Z\xf2\xa1'\xe6\x1e\x87&\x0f\x1b\xf2\x88\xec\xa1\xf6\x13\x9e\x1cK\xdf\xf5\x13\x12M\xf8\xd4]5!\x8b\x93\xbdz'\x13]\xdeob\xc1\xf2\x8f\xfeJ\x98\f\xba\x9d\xe4=\xbdbr\bU\xf4\xbc\x9el\xf6\x14>\xb2\x05\xf5\x1f\xe5)0\x19\b\xcd\x7f\xa0/>9\xbdG\x8c\xe7B\xf8\x90\x9d\xb2\xfa\x8b\x9e\xc9\xee\xe5\xb6z\xbcR/\xb8\xf6\xdc\xd7j\x01\x18\xb0x\b\x98\xb6\x82\x04U8\xec,.\xb3j\x8b\v\xf2)y`@\x06NiNN\xb3kjOV\x89\x85OR\a*\x9dN\xf1~\xb8re\xef$ꇉ_D\x13?\xe9\x969\xd2*\x8a\xb5\xe0M\x15M\xbf<\tVy\xe6\xe5\xbcH+\x8ag\x89t\xbbM\xbb\xc3:\x90r~\x97`\xb9\xe5\x8b;\x1f3kj\xbdj\xd9\xf2~s\xa8[=B\x93\xa9\f\xecW\xd6xT\x89;\xd43\xfb\xeaA3f\x17\x10d\x8e\x8b\x04\x9b\x8b\xbc\xf6\xff\xf7\x89\xc6\v\xb2g\xb7:ܐ=\xd8m\xa7C\xb7\xb2f\xb3ge;EEw\x8e\t;<L\xf8h\xa5\xef\x87\xfc\xdc05-L9\xd9\xf9\xd9a\xe9$^\xb5\x05\xafw-\x1a\x8fX\a\xb6\xf6\xf2xh\xd2fuƛ\xbdM\xd3\x1d,r\xb2R\xb0\xc1\xebh>\xaa첞D<eF\xe3\xf6\x05\xea}\x1b0\x9d\x97'aē\xf7I\xaa\x1eU'\xa7\x12\xd9ww\x8d\xa7\xeaS\xfb\xac\x14\xeb\x8dq\xf5u\xb7\\\x89Y\n\xb6\x02|L\xf4\xa5v;aJ\a\x94%\xe2\x1d\x89x\xe6\x00\xcb\x1dʌ\x19\x9b\x94~\x8b&\xce\xc9t\xec\x14\xe4|\xe6\xbar\xd6r0\xc4)\xb3\x02\xbc\x8a[c\xb1\x0f\x01\n\x06\xb6NC\xb2\xef\xec\xe9y\xd9\xe8\xfe\x9e05\x1f\xabԯI\x91\x88뀍\x96ݓ\x8cf~d\x1aP\xef\xfe\x95\x1e\xf9sR\x00,xz\ued46\x1c\xbd\xa7\x8e/\xb6\x9bʋ~\x15@\xf4c\xd0\xf9\t¡\tR\xfc\xa5\xee\xaf\x17\x01\x0e\xdd\xe3v\x7fZ\x18\x7f \xe7\x1e\xd9\x040\x9c\xfb\xf3\xc3i^\x998\x1e\x1c\xfe\xdeؠM\xadfz\xf9\x947\xdc$c-\xfd<^\xf5\x91t\xcd\x0fBO4c \xe8N}eo\x8f*\xe7M\x1b'q\xfe\x11\x80\xf9\x18\xb1\xechҊd\xdf\x02*{\x06m\x9c\x8e(\xb6\x01\xf0\xa3D=\xf7 \xb8\x95_%\xf8\x14\xff\xf8\xf2s\xc6\a\x9d\xbeB=\f\x81\xf2\xd9\xea\xa5\xc1賓\xe5;\x8f\xed\xdd\xfcZ\x03\x1fG\xc0\xb4\xba1\xf8\xbb\x9e@`\x04\xda\b\x82\x8f;\f>\xcbVg\x0f\xb4p\xdb\x1a|\x8e#\xc3%\xc7\xe7\xf1\x05\xc0auO\x9a\xd5.\xb2R\xb4\xa7Ս Dvz\xd7\bʎ\xa3\x94C\xa3k\xf1\b\xa6\x1c\x87\x8c\xf3\xad 5\xb0\x8em\x13\xb9\xadǩhu\xf8\xcfry\xa4\xf9\xab\xd1\x1d8D\t7\xf1\x95\xf9%\xae\xb9J\x978T\xa1\xba\xc5Oa\xea0\xa6}3\xcb6\u0a37%\x87U(\xcb*\x84\xc38\xc2\xf5{9VV\x7f\x99\x7f|\xba\xa2T1-=D_\x96\x91\x8do\f\xe5]c\x98\xdeU\xf7$JGQ!\x18\xc1\x8c\x11\xccl\x1fCeW]\xbd\x8c`V\xc7\xe2Q\x9c\xc2*\xda\x17\xf6\x8db\xb6఼\xa6Fa\xc1\v\x98\xd3\xeco\b\xfb\x87q\xeaay,\xec\xaf8m\x18s\x0f\xa3|\f\xf3\xba\xd4\xd6\xf9\x1d\xc7\xe4+\x9c\xae\x1a\xc6\xe9\x871\x87\x94a?\x89\xcf\x18Ƃ\xe6@80\x8cj\x12\\K\x82\x85\x0e\xc1\x92\xb0\xbf|\xcd0>\xd6\x14p)+I\xb1H\xd1ja-\xcc/\xdfU\xce\xde\x1an:\x97\x9bj\x9dM\xa7;\xb4>\x7f\x9a\x94l\xc3\xfe@\x96n\xfe\x13\xa8;\x82JR/&\xf5\x11\x94\x8d\xa1\x9e\a\xd5\xf0<\xb7\x90\xa89\xa0\xcc\x0e\aF\xb1\xa4\x84\ue6a5x\x05\xd2\x12\x1d\xed\xf0f\x9aw\xb3\xe6Pjiʛ\x1a\xc6\xd0HFK+\x96\rc\xb9\"^>\x8c3U\xbf\xa09\x18\x0e\x0e\xe3\xacè\b\a3\xe6R\x15\x1cT\xd3+:\xfcM\xa1ʐrYӃXI\xab\xf8\xa5\xecZԬ\xe7\xb3\xd4\xd3,\xf5,\xcb\x15\x1d7\x87\xe4؉\x97\x8ea\x1du)\xc9U7\xbd~\f\v'\xd2K\x11\xe9Α\x9d\xddq샥\r\xc3XY\xaf\xe2\xa9ԉ\xa7'P\xcf\xf62\xccF\x17\x16`\a\x83t'\xceg\xa8_\x82\xcba\xe2\n\xecŕ\x18\xc2U\xb8\x81_w\xa2\x9b\xc9ЃG\x10\xc1\x93\xb0\xf0\"z\xf1\xd7\xe8\xc3?b7~\xcc|\xfd\x1d\xf6\xe0\x04\xf6\xca\x14\xc4\xe4\x14\xf4\xcb\\ĥ\n\t\xa9\xc5\xd5r6\x92\xd2\t[v\"%{\xb0O\x06\xb0_\xae\xc3\x019\x88!9\x8ak\xe4Q~\x1f\xc7\xf5\xf2\fn\x94\x97q\x93\xbc\x8e[\xe4\x1d\xdc*\xef\xe3\xa0\xfc\x16w\xca\x1f\xf9\xba\xfb\x00w\x97\xa8,P\xb1?\xcc\x04\x1bb6|\x15\xf7\xb1\xbd\a\xf3q?\x1e\xe0\xe8\x11&\x9b\x9aӨ\x99\xe1\xaeR\x17\x97N\xa3\xb43\xf0 \x1e\x82.6S\xf3a\xee\bQ\xabng5H\xb9\xa1\xf4\xa8\x16\xaf\x92\xd3C\xdc\xf1Nzo\x90\x96\xfd\x06G9\x17@\xab<\x8dc\x1c\xf9\xb1X\xde\xc0\xd7\xf0u\x95\xea%\xbeL\x1ert\x18\xdf`\xee͖w\xf1'x\x94Y\xba@~\x8a\xc7\xf0\xa7\xf4\xf9\x9fe ơ\xfaf^\xb6\xba\b\xf78\xc7O\xe0[i\xd0Y\xc1\x19\x95\xf1Z\x9d\xef8|u\xe3@\xe0\xe2\xd6=T\xe2P\x0eniY\xdc:\xe8͢\xa4\x88\xc5\x11\xb2\xb8דœ8\xee\xb2(\xddJ\xab\x89\x1d\xf2\xbd1\xacRP\xd7L\xb0XM\x10i\xe9bί\x19\xc1ڎ\x86,\x9a\x8cb]\t\xb6\x8fa=\x83\xb2\xb5\xd9\xd70\x8a\r\x84\x12f\xac\v%xM\x8d\x1c(ik\x0e\xb8l6*6\x9b\xc8\xc6\xc9{\xc6\xf79\x19&\xe7\x92\xc9y\x8cc\xcd\xe5\x12\n\x87\xd2\\.U\xa34 \xe9*e\x9a|\xf7\xe3\x8cp\xd0+\xa3F\xb1\x99̎a\xfe\xf8\xf2i\xc5\xcb'\x8e\x86\x83\x15\xe7?\x85\v(\x85*lQl_F\x99\xc3\xfb\b\xb4\x8avN\xf8¡\xb0>\x8a\x8eRE\xbf\xb4\xb4ɯ`q\xab\xb2Yi\x17P\xb0\xe2h7O\x8d\xd2\xdai\x95~\x95\x8dMO\xe1\xc2&\xff\xb1\x13?R[.*\xde2\x90\xddr\xb1\xf2\xc2(:\x9d\xed\x8e\xf0S\xeb+.\xf1=\x8bm]\x0e\x1c\xf1R\xed\x1c\xc6v\x1a\xb4\xaa>c\xcfe4\xa5\x92)\xdeU\xb1\xc35ʟG\xde\xe5Kst7\x9e\xb8\xb3~\x18;\x1fς\xc05\x98\xc7\xf6a\x86\xff#\b3\xc8k\x19\xde\xcb\x19\xd8\x172\xb4M\x86r\x9ca\xbc\x9f\x81|\x88A|\x1f\xc3\xf89\x06\xee\x0f\x19\xa4\xff\xc4\x10\xf99\x83\xe4=\xa6\xe3\x1fx\x8d|\x80\xa7E\xc7\x18\x01\xe0\x19)ǳr\x16\x9e\x93\xf5x^\xda\xf0\x82\xf4\xe1Eُo\xcb]xI\xbe\x83\x97\xe5U\xbc\"\xaf1\xcd2\xc9|\b\x1b\xd935q#\xa1G%U\x88\xb2\xbfN\xaeO1$\xf73(G\xf14\xd3\xf0\xe7\xa8\xc6\x18\xe9\xd4\xff\x03\x99\x8bg\x9c\x1d\x17r\xe5(\x9ee\x906\xc3MH?e\x1bN\xaak2\x0f\v\x9d\xb9\x00u\xb8\x82\xba\xbf\xc0\xb9\x8b\xc8љSA\x9dI\\\x8e^ķ\x19\xf2!y\t\x7f\x8e\x97\x98\x1eay\x12ߡ\x06\xa5X.\xf7\xe2e\x16\">e\x19^!t\xf8\x9dd>\x8b\xbd\xab\xe7\xabY=\xff\x82\x10\xe2\xca\xfa.\xe7\\\x1e\x7f\x99\xe5\xf1\x1a\xd3]W?ɥo\xfb\x9f\xb0\xa7\x1e8\x7f\f\xbbx\x98\x97\xb7\xfb\xd6.~\x05\x81\x8a\xf6-u\xa3\xb8\"\xf7\x1a\xc6\xc2\xf45\xdc\xec\x9f{\x04\xa1z\xdepWn\xafW\xd0~\x95\x1a\x95\xae=v\xe2-\x9e\xac9\x0e\xefKT\xdaRv\x90RuJ\x9c\x8a\xd71\x1d\x7fK\xd0\x7f\x03K\xf1&k\x93\xef\xb36y\v\x1b\xf0\x03l\xc2\xdf;\xa7\xb1\x96\xdaԳ\xb8x\x9d\x10\xeag<\xd4g \x8e\x17\xc3\xdfp/\x9c\xd1\x1b\xf4-K_\xee|\x13\x7fG\v\xd4\x7fK\xf9>\v\xb0\x1c\x88s\xe8\xdfb\xef\x02\xdb\x0fX\xe3\x1c\xa5\f\aR\xa4\x85\x9eQV\x1fY\xdc^\xff\xdc\xda\xd2&_\xa5o\xee\x83謯\xf4-o\xf6;7\xaf?}\xf3\x86\xc7\xd0\xddUѓN\x00\x7f\xe7S\x88\x8c\xc0\xe2}\xae\x92\xbc7\xb3\xdeW\xb8~\xb3\x8fW\xe7cch\xebRE\xd4\bv\xb7\xd7;\xc0´\xebh\xc8\xe4iC6M}\x8eL_}&\xbb\x7f\\?\x0e\x94\xae\x17\xdf\xc6L\xfc\v/\x90\x9fa%~A\xbb\x7f\xc9k\xf3m\x9e\xf2;\xbc\xf6\xdeeQ\xfa\x1e\xd1\xf8W\xac\xe1~\xedxq\x17#t&c\xfa\xa8sQ\x94\xf0\xfb\x87\x84\xda\x12\x8e\xbb\xf0\x0f\x8c\x9eR\xfa\xeeRz\xf6\x01z\xf66LqbՇ\x14\xa3[y\xbb\xd4\x01\xe6Law\x84W\xac*\xec\xf6\xf0\xccT\x9c\x97\x94\a\x99{?J\xe3{#{\xe5m\xe55ߣ\x05\x95\xe2\x8a\x1cd\xf7\xf3\x96&Cn\xfe\t~걹\xb4\xb0\xcc\xf4\xde\xfcϞ\x92K&'\xf9m\xba\xb0x\xb3LB2\xfd\xf5\xb3lY_笰ʞ\x81\xe80\xf6\x10\x9f}\xc7\xe0+\xfd\x16\xea\x1ew\xae\xae\\N%\xc4\f\xc5\xfd\x17\xe8w\xb0n\x1a\x8b\x9a\xa8\xa3\xccF\xf6q\xccA\x82\xfd\x00\xfb\x16\xf6W\xb3O\xb2\xb7\x19\xd1)\xf6\x83ܹ\x8f\xfd~\x9f\x8e\x03\xec\x878?\x8f\xfd5\xec?\xce\xfeZ\xaeW\xb2\xbf\x8e\xfd\xf5\xeco\xf0\x95\x10\xc1\xa2\xb8\x89\xf4\x9f`\xffI\xd2]\x8c\xdf\xe3\xf9@\xe8\xff\x00PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\x90`\xf7ť\x02\x00\x00)\a\x00\x003\x00\x00\x00org/gradle/cli/CommandLineParser$AfterOptions.class\xad\x95\x7fO\xd3@\x18ǿ\u05cdut\x83\r\x14\xc4\xdf\v\x82@\v\x94\xa1\xa2\x881Q\xfc\x11\xcc\x00\x93\xe9\xa2$\x9a\x1c[\x9d\xd5\xeej\xba\xa2\xf8\x12|/\xfe!\x89J\xa2\x89/\xc0\x17e|\xae\xab\xa3\xb2\x91\x821Mz\xcf\xf3\xdcs\x9f\xe7y\xee\x9ek\x7f\xfe\xfa\xf6\x03@\x11K\x1aTLjHc\xaa\x97$]Ŵ\x86$f5\x98\x98SQT1ϐ\xa9\xba\x8d\x06\x17\xb5\x92-,\x86ђ\xeb\xd5ͺ\xc7k\x8eeV\x1d\xdb|Ƚ\xa6U[\xde\xf3YbHݰ\x85\xed\xdfd\x18\x9f\x8cw\x9f\xaa0$\x97\xdd\x1a\xc1sҰ\xb6\xd5ش\xbcG|\xd3!\xcb`ɭr\xa7\xc2=[\xea\xa11鿴\x9b\f\xd9[/|\xcb[\x7f\xe3ۮ\x90\xea\x8a\x10\x96\xb7\xec\xf0f\xd3\"\xd5\xdc\x1f9\x123H\xc2\x1b\x8b\xae\xa7\xac\xf3\r\xfe~\xd3*\xfb\xdc\xf3[F\x86\xa1\xc9\xd2+\xfe\x96\x9b\x0e\x17u\xb3\xec{\xb6\xa8/Mm0$\xb8W\x97\xc9uL2\xf4\xb9\"\x82Pq\x89a\xa0%\xb7\xa2ҤO5lt!w\x89\x15_D\a[\xee\xbf\x1b\xe6\x9fqŚ+\xfeT\x93\xf9+\x83\xdb\xddj\x8b\x8f\x17\x8d\xa4\xe22\x03\x18V\x0eq\xcc\xf1\xe4b\xd0\n\xca\xf6\x9c|\x15\x19\xc6\x0e\xb3\x84A+\xbb[^պg\xcb\xd6\x18\xee\xf0\x99\x95Ef\x91AV\xbe\xaed\xd1\vM\xc5\x02\xc3b,\xfd\xb1x-\xdcw\xa2\xcb\xe9\x8d\x1c4%c\\Uq-\x8bE\\g\x989R\x0f2L\x1fe\xfb\x19\xe6\x8f\xde\x1dt\x83\xe3w\x95a\xa2\xe3D\x0f:\xafB\x9c\x1fÇn\xbd\xfe\x1f\x1a&ڊ\xff\x9anG\\\xba\xc1\xbcV\xbb\xbb\xed{\xbc\u009d-뀏@\x05\x05\xfab\xa6!\xdb\x7fP\xf6\x14I=Pd\x9f\x91\xa5\x8f\xb4\xfb\xa4)4ft\xb6\vE7\xbe \xb1C\xaa\x82~z\xf7#A+W\xd1\xc3֠\xb1u\xe4\xc86\xdcrG\x1eǀ@\x92XF\xcfq\f\x85\xd0\x05\x1a\xa5\x97\x92\xf8\xd8f\xa5 /a9\xc2P\xda\f\x85,'\x02\xc6\bN\x87\x8cUZAё\xfb\x8e\xe4SC\xff\x8c\x84̰\xe7\xd3>\xe0\x93\x00Xh\xb9\xb6\x81\xb9\x10(\xa53$)$\x9f\xc5\xf9\x10};\xacY\x93T\xe3+R\xfa\x1eU\x933\xec\x19\xd2\xecy$U\xadM\xd6Z\xe4\xbc,|4\xe4\xdd!\x1f\x19?\xa5\x1b\xbb`;\xfb2|\x10\xc90\xd5\xe6\xa4p!\xd86)\x8da<\xc8\xe8b\xb0r\x82N\n\xd0\xe9\x9cT\x18\x18 \xaf\x934\x9eJ\xca\x1f\xa0\x81s4\x16hD>M\xff@\x033\xd0~\x03PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84BQ\xc6b\x8c\x97\x02\x00\x00\x9c\x05\x00\x003\x00\x00\x00org/gradle/cli/CommandLineParser$OptionString.class\x95S\xedN\x13A\x14=\xd3\x0f\xb6,+]˗(\x9a\x82U\xda-e)\xe2'\x88\xc6\x1a\x13#\x01\x13\f\x06\xff\r\xedZ\x96lw\x9b\xdd\xc5\xe8\xa3\xf8\x04\xfe\x95D \xd1\xc4\a\xf0q\xf8\xe1Gb\x8cw\xa6+B\xdb\x04\xf8s\xe7\xde;s\xee=\xe7\xce̷?\x9f\xbf\x02(\xe3a/Ρ\xa0\x921T\xf4\xa3(©\x14J*\xa6a*\x98Q\xa1\xa0(LY\x98\xd9\x14n\x88\xb3s\nn)\xb8\xcd\x10\xe7~\x9d!\xb3\xb4\xc5\xdfp\xd3\xe1n\xdd\\\r}ۭ\xcf3\xf4x\xcd\xd0\xf6\\r\x16l\xd7\x0e\x17\x19&\xf3\x9d\xe7:3\x855\x86DūY\f\xe9%۵\x96\xb7\x1b\x1b\x96\xff\x82o8\x96\xe8\xe4U\xb9\xb3\xc6}[\xc4Q2\x11n\xda\x01\x83\xb6\"\x1b\xb6\xcaP\xf8\xd4u-\xbf\xe2\xf0 \xb0h\xd7\\\xf2\xfc\xbaY\xf7yͱ̪c\x9b\x15\xaf\xd1\xe0nM\xb4x\xce\xfd\xc0\xf2sG\xf1$\xa0\xbfn\x85\x8f\xed\xa0\xe9\xf0w˼A}\x06\xf3\x85nBS\xa1\xd7\xf2\x15\xdca\x00óS\xe9<\x99OY\xce\"\xf6vF\x98\xb20\xb3\f\xb9\xd3\xe0\x18\xfax\xb5j\x05A\xae<7C\xf0J\xfe\x8c\xea\xbb\nUW\xbdm\xbfj=\xb1\xc5Ї;*L\v\x88\x86\xf3\xd05d0 \xcc]\ri\xe8ļTRpO\xc3<\x16\x18F\xdaK?ڶ\x9d\x9a\xe5k\xb8\x8fE\r\x971\xc6\xc0J\x1a.aL\xc1\x03\x86ҙ\xa83\xe8\xff\xeb\xafllYՐa\xe2\xe4\x99\xd1[\u038bq\xeb\xed\xecHw\x10r?\f^\xda\xe1&\xc3P\x97\xcb-\xbc\xa2WΛM˭\x11\xddn\a:R\x91f\x9aj\xf6$n\xc8ҏ\xeb\xa7\xdf\x1a\xa3\x89\xd28\xc9ˈ)Ӛ\x94\xb9\x01zs\x83\x14\xadS\x14\xa75m\xec#n\x14w\x910\xa6v\xc1v$tH\xc2\x12d\xbf\x93\xfd\x81^\xfc\xa4\xa2\xbf0L\x99l\v\x86\x11\\\x04\xa4'\xda0\xe9\x89F1\xf2\xe96\xa26\xafe\f,\x18\x9f\x90\xc8$\xf7\xd0\xf3\x1ec_\xa0\xac\xef#%\xc2^\xca3Z\xf6\xa0~\xc0迍\xbec\x1b\x1f\x0f9\xf5\xc8Z\xbf%\x0f\xadU7\xe2\xc1\xc4S\x88z\x9a\xb4\x8a\xbd\xa4\xb1\a\xad\r\xcc\xd8\x11p\xb2\x05օ\xd8l\x04^!yB\xb8b\x14\xa7\xf6\x11\xdbi\xeb} \xe1F\xeb\xc8\xe1\f\x14\x8cG3P0!g \xbc\xab\xc8!\xae\xa7p\r\xd7;\x98\x91\xb8vY\aǘ\x8d\xcb\xd21LJ\x9bǨ\xbc\x92\x18\xdd\xeeM\\\x80\x8a+\xb4BO\xfd\x05PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\xfd\xb9E#\xb1\x04\x00\x00!\f\x00\x00;\x00\x00\x00org/gradle/cli/AbstractPropertiesCommandLineConverter.class\xadV\xddW\x1bE\x14\xffM\xb2aò\xe5#6 -\xa5\x94\x02\x06HI[\x05j\xa1h\nTbÇ\xa6\x82\xb4\x15\xbb$KX\x1av\xe3\xee\x86\x06\xbf\xeaǻ\xef\x9e㻯\xfa\x92\x8a\x1e=}\xf6\xef\xf0\xefP\xefl\x964\xc9\xc66嘜3w\xe6Ν;\xbf\xfb\xbb3w\xf6Ͽ\x7f\xfd\x03\xc0\x15|+\xe1\f\x12\"ޕЋۼIJXƊ\x84U\xac\xf1\xe1{\xbc\xf7\xbe\x88\x94\x84V$$\xdc\xc1\a\x12ֱц\x0f\xb1ن\xbb\xb8Ǜ\xfbA|$\xc1\x87\xad >nC\x0f\x1e\xf0\x81\u009bm\xee$-\"å*bGD\x96\xa1eV\xd35{\x8e\xc1\x1f\x19]g\x10捌\xcaБ\xd4tu\xa5\xb0\xbf\xad\x9aw\x94\xed\x1ciBI#\xad\xe4\xd6\x15S\xe3cW)ػ\x9a\xc50\x9d4\xccl,k*\x99\x9c\x1aK\xe7\xb4X|۲M%m\xaf\x99F^5mM\xb5\xe6\x8d\xfd}E\xcfp\xb7\xf3\x86~@J՜a\xe8ʪ\xc7F\x87\xaby[3t\x86ӑ\xd1\xe4\x9er\xa0\xc4r\x8a\x9e\x8d\xa5lSӳd\xda\xeb1]PmE˩\x19\x86\xbe\x06sV\xda\xd4\\\x8f\xadiC\xdfѲ\x05\x93 \x0fG\xea\xc1VA[SL\x8bpq\"Z\xf2N\x9fa\xf0\xc5\xf6dm\xb8[=Ϻ\x8c\x8c\xac\xdbt\xf5QB\xb7lEO\x13\xa2\xae\xe3x\v\xb6\x96\x8b-+y\xb2hMiY]\xb1\x1d\xc4\xd3\xf5\xf3\xb3^z\xbc\x9a9\xf2\"\xa6\xcbT3\xdc\xf4D\xed@\xcfT\xa1\x9b\xa9\x03\xe1\x05\xe5\xcf\x1b\x94l\x96\xa0\xc3\xf0P=\\Wr\x05u\xb1\x987U\xcbrB\x0f5ʚO\x1b\xa2\x8cV\xb9JP\xe2\x15\xdbउeҬ\x06\xacy\xd11H\xf9\xcai\xe2\a\xb4\x1e]w\xed\x01=\xcc\x1f\x1f\xd2\xc9\x13\xb2'-\x16\xd3j\x19\xa1\x88]\x86\xef^\x9a\xc3&\xb7:izk/\xca\xea\xf6\x9e\x9a\xb6I\xbd\xd44\xce\xeau\r]\xf9\x8a\x97ys\xa56\xb9\x95\xe9\xcc\x7f\xdd\xfbF\xb7}\xf6DQ:\x89H\x19\x053\xad\xde\xd2x6Ǜ\xab-\x13ܗ\x8c\xb3\xe8c\xe8\xac\xf7+c\x10\x17e\f\xe1\xa2\bM\xc6\x18\xf6D<\x94\x91þ\x8ca>\xa3à{\xf9\f\xef\x92b\xed\x12f\x11yn\xfd\x89\bS\x86\x05[DA\xc6\x01\x1e\x89(\xca8ħ2>C\x9cn\xc8\r\x19\x9f\xe3\v\x06\xc8\xf8\x12\x8fe|\x85\xafy\xf3\x8d\x8c(.1\x9c\xaa!B\xc6e\x10\xc1\x93'*\xa1\xc4\xc7K\xa4\xc0k]e\x157\xb3\x85}U\xb7+\xa7\x9ea\xe0E\x95\x8f\x17\xa7{\u07b4\x8d6S\x04\x9f\xe3\xfc\xf8%\x90w\x15\xeb\x18\x16\xdd\xf9\xa1HS\x8e\xdbiUM\xf9\x9f\x8a\xfcO\x10=\xf7\x88a\xb6\x19ߞu\x95\x1dF\x9a\xb3\xa4\a\x81^8\xa7\xe2\x12\x0f\xa1\x9a\a!\xa9Y\xfc.\xb6\xd7j\x18\x82\x9a[i\xa94\xd6,\xa8\xaa\xc0!\xaf\x96\xca2\xf1\xb7\xa2\x16m\xe7\x93\xe0.=\xf2\xba3\x105=\xa3\x16Ww\x18\u008dbN\xf0\xf7\xa1@v\xd7\"\xdeR\xd1d\xb9i\xb5\n\xfc\xf4\x92?\x8e9\x91h\xf8\x1d\x10\x8e4\xd2\v\x17\xe8\xa3\xe6\f\xf8\xaf\x15\x8c\xdf{j\xcf\xd1(F\x92n\"\x02cO\xc0~\xa6\x8e\x0f\xfdԶ8\xcan\x9c\a\xbf\xa5\x8e\x01\x06p\x01\x10\x04^\x1c\x1c9\xe4\xcaa.\xc9f\x04\xaf\xb9N\xf3d\xed'92\x1e\xf8\x1d\xbeM\xffX\t\xfeԦ@BH\x95\x10X\x8e\x96в\x1c\xa5\xa1XBp\xe3ٮ!\b\xd4\xf6\xd1g\xd99j\xfb\xc9\xf3y\a\xc1@\xd9[\x19\x81Ӌ`\x94\xf6\xeaA\a՛qZ-\xf0\xd2A\x92\xef~\x95$G\x1c\xfc\r\xad\x9bO \xfdT\x17\xd5`UTA\xd7\xe7\x84c\xc3ɠjC\xbbq?\x7fQ\x14-$\xb7\xc69\xfe\x12\xdaJ\x90\x8fp\x8aa\xe5\xd2\x11\xda\x19\xbe\xc7\"u:\x18\x9e\xc2w]\xe8\x15B\x9d%tM\x05\u0081\x1f\xd0\x15\xa5a\xe8\b\xaf\xf8\xb1\xf1#\xce\xd2\xc8\x1f\x0e\x94p\xbaW\b\a\x84\a%\x84ݩ\x7f~\x89rt~\a]?D'\xb6a\xe2q\x82\"|\x9db\x8cSt\x9b\x14\xd9}\xd2p\xd4s\x84h\x82tWi6@\x96o\xd0\x7f\x92\xa2\xef\xc2mLa\xda\xe1i\xab\xc2\xd3\x16\xae\xe1M'\xce-\\\xc7\fE8[\x89\x9ckn\x90f\x8e\xfa\x02i\xder9x\xbbs\x81\xb8\x8c{\x8eG\t\xdd\xf5D68\x1e\x9dq\"𦛈%\x82\xc3\x01Ic\xe3ѧ\xe8)\xe1\xd5\xc6\x1e\xca\xe9\x95*\xb0%̻\xb0%,`\xb1\x06\xa4υy\v\xef8r\xe9_PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\x93\x9d\xbc\x15\xbc\x02\x00\x00 \x05\x00\x00,\x00\x00\x00org/gradle/cli/ParsedCommandLineOption.class\x8dS[O\x13A\x14\xfe\xb6\xb7-\xed\"\xa5\\\x14A(\x17\xa1-ʊ\x17\xbcP\xab\x041!iĤ\x06\x83oC;Y\x86lw\x9b\xdd-\x11\x7f\n\xcf&\xbe\xf8\xa0\x89J\x90D}\xf6G\x19\xcf\xec.\xa5\x14I|\x99\x9d9\xfb\x9d\xef|\xdf93\xbf\xff|\xff\x01`\x11+)dPPQL!\x82B\x0f\xfa0\x9f\xa2冊\x9bI,\xa4\x90\x80\x9e\xc6-,&q[~器+\xbf\xf7䲤⾊\a\n\x12{\xcclqWA\xa6\xb2\xcb\xf6\x98\xde\xf2\x84\xa9W\x84\xeb-+\xe8\xa9\n\xc3b^\xcb\xe1\nf\xba~\x97\x82\xb3\xc9,C\xafz\x8e\xb0\x8c\xe52\xa5$J\xc2\x12^YA4_\xd8T\x10[\xb5\xeb\x94\xdcW\x11\x16\x7f\xd1jls\xe7\x15\xdb6)\x92\xad\xd85fn2G\xc8s\x18\x8cy;\x82\x94\xe4+\xb6c\xe8\x86\xc3\xea&\xd7k\xa6\xd0_2\xc7\xe5\xf5U\xbb\xd1`V]Rm4=a[T.ipoS\x1aP0\x98/\x9c\x97D\x1eN\x10D\x9c=\x81t\x9a\x9c=\x17\xbc\xc0Z\x9a\xd5\xeb+\x8e\xd1jp\xcbS0\x94?\x8f\x92\x8e\x93\xac\r\xc9\xfeKOr\x87\xb9\xa1bj\xd1\x1b\x05\xa9\xaa\xddrj\xfc\xb9\x90\x1d\x18\xbb\xc0\xea\x82d\xd20\x84a\x05\x03\xa7jW\x1c\x87\xedK\xc9\x1a\xfa\x91\xd5p\x1d\xb3\n&N\xab\xae\x9b&7\x98Y\xf5\x98\xc7\xd7\xdeָ\xcfE\x88\x804W\xb7\xb9\x9b\xb3l/\xb7\xc3\xf6x\x8eY\xfb9\xff2,\xc8BS*\x1ejx\x04\x92<\x1a\xc2Iy\xae\xd12=\xd14y\x80t\tZ\xc2c\xba<\xddN5\x94\xf1D\xc3S)h\xf6\xff\xc6y\x86ec{\x97ר\x87\x97Ύ\x86\xee\x88+\xde\x05\xbd[\xa7\x95\x86+G\xb1\xde9\xfa \x95tGi`]\x83\n\xffɾ\xab\xc2]k4\xbd}Lқ\xc9Ѓ\xa2\x81\xc9.\xd2w\x80N\x11\f\"N{j9\xad\x97)RF\x94v@\xa6x\b\xa5x\x8c\xc8\xd6!\xa2_\x11\xfb죯\xd0*\xdf!(#F9#\xb4\xd3\x02<\xaeb\xd4\xe7\x1fõ\x90\xab\x19r-\x15\xbf!~\x80\xdec$\xb6\xb2\xea!\x92?\x8b_\x10;B\x0f\x19}\x1f\x86S'\xe1\xe8\x11\xd2\x11\xfc\x82\xf6\xa9]q\x984\x82j\xa9T\xa3\x9f\xf8ǩB\x1e\x13\x1d\u0557\xda\xd5\xc7)\x1e\xf1\xab\xebt\x92\xff\xe2\x92\xf5\x94,\xe1\a\xa7;\x92\xe3arА\x1c\xc5&1\x15ZxF\x11iW\x93$\xf3G\xe8\x8d\xe0uw+\xe6\x90&9#\xbeP\x1f\x1b\xf2\xc9\xdd4f|\x19tgC\xc6R(+\x1b\xf4\xe0\x92\x82\x03\xa8\xb1\x0fd\xfcc\x97\xc6\xf9\x0e\x8dٶ\xc19\x1f\x95\xff\vPK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B7Ɋ\xaf\x99\x02\x00\x00\xaa\x06\x00\x00=\x00\x00\x00org/gradle/cli/CommandLineParser$OptionAwareParserState.class\xadU]O\x13Q\x10=\xb7]\xba\xedv\xb1\x15\xa1\x80\x88b)B\xcbG\xa1\x06\x91\x0f\x15$bH\x1a4\xa9i\x82o\xb7\xedZ\x17\xb7\xbb\xe6\xee֏\x9f\xe2/\xf0\xc5\aMD\x8d$\xfe\x00\x7f\x94qnYjIk\x16\x89/\x9d\x9d\x993\xe7L\xef\xcc\xdd\xfd\xf9\xeb\xfb\x0f\x00K،!\x8eI\r:2\xf2iJ\xa3\x9f\x1b\x1a\xa61\xa3!\x8b\x9c\x8aY\r*\xe6U,h\x88aQŒ\x8a\x02C\xbc\xea4\x1aܮ\x15M\xdb`H\x17\x1dQ\xcf\xd7\x05\xafYF\xbej\x99\xf9\xc7\\\xb8Fm\xfb\x0ff\x9d!\xe2=7\xdd\xccb\x0ft\a\xaeU($zôM\xef.\xc3Ù`x\xb0|\xb6̠l;5j6!\x03{\xcdF\xc5\x10OxŢ\xc8@ѩr\xab̅)}?\xa8\xc8v\x19R\x8f^z\xa6co\xbd\xe6\xc2W+yܣ\xbc\xbekۆض\xb8\xeb\x1a\x84[\r\xec2ӛ\x89\xfek\xb2\xc1\xdfV\f\xf2\x84w\x8ca\x18\x9a)\x1e\xf0W<oq\xbb\x9e/y´\xeb\xeb٧\fa.\xea\xb2\xe1\xae$\x8dı\xf7\x1c\xfb\x84 ~\xaa\xd9\xfb\xbd\xe8\x82;>ݦVr\x9a\xa2j\xec\x98\xf2xR]\xe8\x05\xa9\xa0#\x81\xa4\x8e\x8bX\xd6я\v:naLŊ\x8e\xdbXU\xb1\xa6c\x1d\x1b\fˁ\xca[\xcf<C\xec\x98\xc2\xf5J͊\x9ff\x18\xec\x15\x96jw\x18\xe6\xcf\xc6y|>40\xbdӕ\x1c\xb4k+\xe7\x9c!\xc3ܿ\x9c\xa5\x8a{\f`\x98\x0e^\xed\xccRku\xa3\xa6{2؉\xa0]g\xe8\xe7\xb5ڃ7\x9e\xe0en5\x8d\xbf,S\xb9\x9b\xaaK\x9dfΫU\xc3u3ˋtq\xa7\xcep\x15咾\xf8/W\xf6\xacg\xb3ۥv~\xaet0N\x99\xa07\xa4N\xef\xce\x10\x06\xe5\x8a\x03ɤ\\z\x8a\x84\xa1\xd0\x1e\r\xd0d/\x91W$D\x98l27{\b\x96c_\x11\xca\xcd\x1d\"\xfcɯ\x05\xd5R\x9e\rCc#H\xb0Q\fQ,E9\xaa!;\n\xb4\x9e\xa4F\x888/c\xccg^#_\xa2\"\xb9\xd9/P>\xb4\xf9\"\x90k5\xde\xc1\x13i\xf3Dp\x05\xe3\x94g\xb8\x8a\t\x9f\xe7\x00}-T!\xf7\x19a\xa2\xea#˾!\xf2\x0e\xa9#\xa8\xfbғ\x19\xea<\xfa\x1e\x89#\xc4\xf6}W\xfb\xd8\xd6\xd4$\x03K#\xca&;t\vm݂\xaf\x1b\xc2\xf5VM\x1a#ds\xa4\x1c\xa7\xef˰\x12\xa2\xb3\xcc\xe2\x9a\"\xbf2Y\xcc\x11\"F6O\xbc7\xc9\"\x19\xfd\rPK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B$\xb8R\xfc<\x01\x00\x00Y\x03\x00\x00)\x00\x00\x00org/gradle/cli/CommandLineConverter.class\x8d\x92]K\xc30\x14\x86Of\xb7\xba\xf95\xbf\xf1Z\xc4V\xd1\xea\x8d\x17\xeb\x18\xc8P\x14\n\n-\xdegm\f\x19m*Y:\xfcm^\xf8\x03\xfcQb\x96B).\x83\xf6\xa6\xc9y\xf3\xbeO\x0e'?\xbf_\xdf\x00p\v\xc76\x1c\xd8p\x88\xc0\x8es>'B\"p\x9c`\x8a\xe7\xd8K1\xa7\u07b3$\x02OR\u2ef5\xe2\xcbdJb\xe9#\xe8=|\xc6\xe4C\xb2\x9c\xcfl8B\xd0\r\x19\xe5X\x16\x82 \xb82\xc5\fk\xb5P\nƩ?\xf2\xdd(RY\x03#v\x99j\xbc\xc8Mc\x98b\x95\xbc;'\xc8\x05\xf5\xa8\xc0IJ\xbc8e\xde+\x163\x92\x8c\xf3,\xc3<\t\x18_\xd1\xf4y\x13\xa3F<58ٰ\xc1\x8b\x06QUk]5\xcbwF\xf5\x18Ζ\x8c5\x8b\xce\x10\xbe\xfb\xa6\xa6>\x8c\x06\xcb\xe0\x91q\xeaa^\x88\x98<\xb2T\xe5\x9f\xd4\xe2\xc6\xe5\x13\"\xe2z\xe1Bp\xba\x9a\\\x1dE\xd0\xff\x8f@p\xb9\xdax/h\x91\x11.\xab\x97\xd7A\x80\xa0\x05\x8b\xafm!X\x03K\xed\xdajg\xa9z\al\xb5j\xc1\xbaV\xba\x06\xa5\xa7\x95\r\x83\xb2\xa9\x95-\x83\xb2\xad\x94\x1d\xe8\xebuYم=\xfd\xdf\xff\x03PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B@ͤ1\xe9\x03\x00\x00|\v\x00\x00<\x00\x00\x00org/gradle/cli/CommandLineParser$BeforeFirstSubCommand.class\xc5V\xebr\xd3F\x14\xfe\xd6V\xb2AV\xc0qqJچ\x9a\xe0\x90\xf8\x16\xc5!%\x17S\xda$\xe5\x1a\x13\xd3\x1a\xd2:\xf4&;\xc2\x15\xd8\x12#˅W\xe8#\xf4-\xe8L;\xa1\xcd\x14\xfa\xaf3\xfd\xd3\xf7\xe8C0\x9c\x95\x8c\xe3`'r\x18f\xfag/g\xbf=\xe7\xdbOg\x8f\xf6\x9f\x17\x7f<\x03\x90\xc5=\x19'\xb1x\x8c\x9a%\x19Q,r\xe4dH\xb8(c\x19\x1f\x87p\t\x9fp|*&+\x1c\xab\x02\xf6\x99\f\x19\x979\xae\f\xe1*\xc75\x19\xd7qC\xc60\xd69\xf22\"\xd8\xe0(p\xdcb\x18t~0\x1a\xf1Y\x86\x89\xbceWժ\xadm\xd7t\xb5R3\xd45\xab^\xd7\xcc\xed\xbca\xea\xb74\xbb\xa1\xdb9B_4Lù\xc4pu\xda\x1f\xfe:\xc25ow\xe0r\x89M\x06i\xcd\xda\xd6\x19N\b\xc3F\xb3^\xd6\xed\xdbZ\xb9F\x96Hުh\xb5M\xcd6ļe\x94\x04]\x86\xe8\xaa~ϲ\xf5+\x86\xddp\x8a\xcdr\xcb'\x83r\xdd4u{\xad\xa65\x1a:\xc1\x16}I\xc6{:\xa2\x93\x86*{\xd0\x1e\xe2t\x9f\x85a\xd82\x8b\x8ef;\x85\x87\x8ea\x99\x1c_0\x8cxc/\x16-:\xe4kk:\x7f_\xfbQSk\x9aYU\x8b\x8em\x98\xd5\\\xb7%\xe1O\xbd\xcb7q\bjvUH\xd7叾\x9d\xe5\xe2I$o\xe0-д\xb0o\xaa\xf6\x1b\xb8\xedx\xa4C\xaaB+\xc6a\xd9\xe4ar\x1cE\x060<x+\xa9\xe4\xcf:\xeb\xa6[\xe0\xf1\xach\xb2\xa2\x99c\x88\xf7\xb3\x8fA.ZM\xbbBi\"rp\xb4\v3#\xe4Vp\nc\nF\xf1.\xc7m\x86̑d\x14\x9b\xef(\xd8ė\x1c_)(a\x8b!\xe6'\xa1\x82\xbb\xf8\x9aa\xc97\xd2\x1d\xf3\x81i=2{$㩃\x96\x14\x9c\xc1\x84`\xf5\x1dC\xea\xe0\x00+v\xb5Y\xd7M\xe7\xf2\xe3\x8a\xde\xfa\xf4gZ.c\xad\xb4\xc8\xd4\b\x17\xf3r.65٘\x9aa\b\xef\xe5g\xa1|_\xaf8\x1c\xdf+\xd0P\x16\x11+\f\v\xbeGZ?\xe0@\xa3\xbd\x17\x84_\xaa\x0f\x17ެ\x1e\xf4C\xc8\v\xb9\xf2H\xb3\xf5\xfd\x84\x0eZ\x98;\xfa\x05\xa7{埮\x87&\x8e\abX\xef\xab\b\xf5{\xa9d\xadR\xd1\x1b\x8d\xf8\xe2,]\xae\xf3}\xdc\xe7\x84\x17\xab\xe9\x185\xf5\xa6\xf6P\x14\xcf}\x06*dU\xdda8\xd7I\xd3˔W{;M{\f\x96\x04\x83\xc9~\x18lq\x90\x9e\xa1}\xea\xfe\xd4K\x95\xb7Pz:kt\xbf\x9a\x86_gB\x15\x9cR\xb3\xae\x91,K=x\xde=\\\xa9v\xb5\x8e\xf6\xd8+\xe2\xfd\xf7\x06e\xd8\xe7\xd7\xe0\xff\x0f\xf8\xbf\xc4M\x1f\xc5+b\xf4\xa0\x8a\xd2;\x8c\x85â\xbc\xd3(\x88\x80\xa8\xf6\xf4\xf7z\x8ff\xd7h\x1e\xa4^I\xa6~C \x99J\xef \xf8\v\xcd\x03x\x9f\xda\xe3b\x8dMc\x80%\x10bI|@\xb6QZ#<\xc6\xc97\xdc\x11U[\xb21\x9cŹ\x96\xd7\x7f1\x84\x01\xea\xf5]H\xa5T\x9a\xed``#\xf9+\x02O1\x98\xfe\x1d<\x80\xbf0\xb4,\x8dI\x7f#뙏\xfd\x8c\xc8.\xe4R\x8a\xa6\xa1$\xe1\x95'\xbb\x18.E\x8eK\x7f\xe2D)\x98)>Ex\a#\xcfw\x11)\x89\x1d\x991\xe9\x15\xf2\x9d'm\xba\xa7\xc1\x89\xee\fQU1\xcef1\xc1\xb2H\xb39\xe4\xd8<\xbd\x17\x17\\\xfa\xf3\x1e\xb16}\x1dS\x98&\xdab\x94\xa0Q\x00!l!\x894\x1d}\x9c\xe4\xc9`\x06R\x18$Y\xb6u\xb8\x02\xadH\xd4sW-\xb6\xa7֠pæ\xdc0I\x0f\xd2\x0e\xc31G\xea3wt\xde\xd5K\x8c\xe6\xf1\x91\xfb=.\xb8>\x16\xf0!\xf5\xab\xa4\xddIz\x0e\x9f&K\x9c\xfaII<\x97\x97\x91\xa2W\xb1J=\xc2C\x10\xcf\xe55\x88\xc7\xf02n\x12.J\xfd\xe7R\x00\xdfP\xff\xad$\xbf\x04PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\x93\x00;\x99|\x01\x00\x00\xfc\x02\x00\x009\x00\x00\x00org/gradle/cli/SystemPropertiesCommandLineConverter.class\x9d\x92\xcbJ\xc3@\x14\x86\xcfثm\xb5\xb6\xd6j\xd5E\xdc5B\x13Dą\x17\x90\x16\x17R/P\xe9~\x9a\x1eӑ$\x13&\xd3B\xdeJW\x82\v\x1f\xc0\x87\x12'i\x10\xa9A\xc4Y\x9c3\xf3\xcf\xff͜\xb9\xbc\x7f\xbc\xbe\x01\xc0\x01l\x97 \x0f\x8d\"l\x14\xa1Y\x84\xcd\x02l\x15\xa0E \x7f\xca<&\xcf\td\xda\xfa\x90@\xb6\xcb\xc7H\xa0\xdag\x1e\xdeL\xdd\x11\x8a{:r\x94R\xefs\x8b:C*X4NĬ\x9c\xb0\x80\xc0Q\x9f\v۴\x05\x1d;hZ\x0e3\aa ѽ\x13\xdcG!\x19\x06]\xee\xba\xd4\x1bG\x8bv\xb97S\"\x8a\x13\x025\x1beb\no}ɸG\xa0\xd1\xd6\xfb\x8ftFM\x87z\xb69\x90\x82y\xb6\xb2\xb6~X{()spL`7e.\xb0\x04KV,\r\xf8TXxɢ\x8a\xf5\xbf\x94fD\x05T\xa0\x00E\x02\xa4G\xa0\x1c\xc4P\xc7W\x14\x81\xe3\x01Jm\xaeh~\xb2\xad\xc6\x1f49A\xedjx\xad\xb5Ѱ\r\xad\xd3s\xc3h\xf6\xcc\rgԙ\xa2n\x108\xfc\xc75\xa9\xdb]\xa0.F\x81\x14Ԓ\xbfs\xb0\a9\xf5\xe0Q\xcb\x02\x89\u03a2\xe2\xb2\x1a\x99*\x13\x95s\xfb/@\x9eUg\tJ*\xe6c\xb1\x0ee\x15+s\x83\xca+1\xbe\n\xd5\x04\xee$p\xa6\xbe\xf4\xb4\x806\xbf\xa1\x99/t-\x05\xcd,\xa2;\xa9h-\x05\xcd.\xa2Z\n\xaa>k\xecZ\xff\x04PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\x9c\xb3I\x7f\x03\x02\x00\x00\xb7\x04\x00\x002\x00\x00\x00org/gradle/cli/CommandLineParser$ParserState.class\x95S\xc1n\xd3@\x10}\xe3\xd8q\xea\xa44\t\xb4@A\x10\xda\xd0$M\x1bSn(\x88\x03\x11HHQ\x8b\x94\xaa\x87\xde6\x89\xe5\xbar\xd6\xd5\xc6E\xf0O\\\xb8\x80ā\x0f\xe0\xa3\x10\xb3vZ\xd2&R\x88%{fvg\u07bc\xb7\xb3\xfe\xfd\xe7\xe7/\x00\ap\x1dX\xd8v\x90\xc5v\x0eU\aϱc\xa3n\xa3AȾ\x0ed\x10\xbf!d\xea\x8d\x13\x82ى\x86\x1ea\xad\x1bH\xef\xf0r\xd4\xf7Ա臼R\xeeF\x03\x11\x9e\b\x15\xe8x\xb2h\xc6g\xc1\x98\x90\xff(\xd4\xd8S\xbdXļX\xf8 \xa5\xa7:\xa1\x18\x8f=\xdelu#廾\x12\xc3\xd0s\aa\xe0v\xa2\xd1Hȡ\xee\x90\xd6U\xa7\xcaۄ\xe2H|\xe9{\x1c\xa9\xf8\xe8\"\x0e\"IX\xafw\xcf\xc5'\xe1\x86B\xfan/V\x81\xf4ۍSB.\x18_\xa5d\x84\xf25˙<\xc2j$\xa7\xd0l\xec\x12J\xa9\x7f\x83\xf7\xe9\x9c&s\xda.\x963\x83\xcd\x1c\xf2\x91<\x8c\xe4\x15ٷ\xf3\xf4,}N\x91\x9cJy'\x876\x9a\x04\x10j\xf5\xc5P\am=m\xe3\xf3\vB\xf5\x7f\xb2\tN/\xbaT\x03\xef}\xa0\a\xbf1\x93\xd3҂\n\xb0\x91\xe3Y췚6\xf6\n\xd8G\xc9F\x8b\xb0\xb7\x8c4V\xf6\xefp\x8e\xfa\xe7\xde &\xbc\\\xfe\xd4\t[\x8b\x85\xddh\x96N\x82`\x8fD<8ӗ\xb7\xb2\b\xc1\xac🕅~l\x18Z>\x0f`\x85#\x97-O\x03\xd6\xee\x0f\x18\xdf\xd81\xe0\xf07\xab\x17\xc9B\x9e\xfdB\x9a\xc0v\r0\x99\tJ\tP\x99m\n\xd2\xe6*CC7˙\xef0\xbfނYI`6Ҕ\x14&\xf1\xee\xe2\x1e\x88\x01\xd7\xf1 \x01~\x88M\xe8\x8b\xf1\xe8\x9a]3\x89\xf9\xbdͬ4ŌRȢ\x86|2)|\xc5֘Ț)\xb6\xa6\xf8X\xd7|,<E%){\x96\xe4o\xe1\x0e\xdbMdx\xa7\x86U\xd3\xe1\x8a\x1a\xee\xb3}\xcc\x16\xc5\xdc_PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\x8f6\x9c7<\x03\x00\x00\xca\t\x00\x00;\x00\x00\x00org/gradle/cli/CommandLineParser$AfterFirstSubCommand.class\xc5V\xefN\x13A\x10\xffm[8\xb8\x1eP* \xfeG\xac\x04\xdaµ \x02\x16\xd0R, \x85bP\x12\xfcvm\xcfzP\xee\xc8\xf5\x10_\xc1\a\xf0=0Ѡ\x92\x18\xbf\x99\xf8(>\x84q\xf6\xae@\xb1\x94\x16B◝ٙ\xd9\xdf\xce\xcc\xcd\xcc\xed\xaf?߾\x03\x88⹈V\xc8ʹDD\xb4A\x16I6\xec\xc5\b\x1e\b\x18\x15\xf0\x90k\xc6E\b\x98\x10\xf0H\xc0\xa4\b/\xa6E\x88x,\xe0\x89\x808C\xa3\xf5F+\x06\"\f\xbd)\xc3\xcc\xcbyS\xc9\x15T9[\xd0䄱\xb5\xa5蹔\xa6\xab+\x8aYT\xcd\x18YOj\xbafM3\xcc\xf5\xd76\xff\xd7\xc2\x16\xe7\xca\xecb\x03k\f\x9e\x84\x91S\x19ڸ`yg+\xa3\x9a/\x94L\x81$\xfe\x94\x91U\nk\x8a\xa9\xf1}I\xe8\xe1\xee2t\xc4_[\xaa\x99\xd4̢\xb5\xba\x93)A2H\v\xba\xae\x9a\x89\x82R,\xaad5V\xd3\xc7\xc0i8\x14\xa77{lyJj*#ah1\xf4UK1\xad\xf4\xb6\xa5\x19\xba\x80\x04C\xbb\xc3;W\x91\xd2\"\xacW\xfd\xa9\r\xe5\xad\"\x17\x14=/\xafZ\xa6\xa6\xe7c\x95\x92\x81ڞW`\x93\x0fn\xc5\xcc\xf3\xc4U\xe0ї3l{r\xaa,\xb4tIvַwlb\x02f\x19\xc0\xb0y)\x1f\xbevxQ\xbb8\\\xef\"|\x89\xf2e\x98!P\xcf9\x06q\xd5\xd81\xb3jR\xe3\x15\xd3Ua3\xc4\xd3#\xc1\x8f+\x12|h\x17\xf0TB\x12s\x02\xe6%,\xe0\x19CO\xadl0L\xd4t䥾\xa9\x1b\xbb\xfa)%\xd0]M%\xe1\x06nrǖ\xa8vk^\xb0X\x05\xbe\xab\x9ab\xb0Κrj\x86\xba\xa9|\xcb\xddZ\xe6K\x9aa\xf4BmUOL\u038d\xf1]\xc5TO\xc6TM1|\xfe>\xa1r\xaf]Eg\x16\x81cDu\xa6d\xb3j\xb1\x18\x18\x8fP\x95\x8e\xd4\xd1\x18\x03Ng\xeeXZA^R\xb6\xf9\xd48!\xa0\x0eΫ\x16C_\xf9\x90Hg6Ԭux\xb6\\$`\x85FՉ\xd0ޟ6^.\xa1\x1d\xcb\xe7L\xbdͻXר\xab\x17\xed\xf7\x05\x06\xcf\xd9\x15~\x96yi\xea\xfd\xafԅσ\x8a\x1e\xfa˷\xd1{\x80\xf9||\xa0\x11熋\xcf7\x9a\xd7\x1d\xb4\x9b\xa7\xbd\x9b\xa8\x14\f}\x86+\x18\n\xef\xc3\xfd\x91\xf6.t\xd2\xda\xcaul\x12\rl\n^6\x8d.\x92u\x91\x8e\xecq\x15\xd7\x01\x9b\xa3\xc9D2\x86[t\x9b\x83\xfa\x01M\xf0\x10\x8d\a?\xc1\xf5\x05\x9e\xf0W4\xb8\xf0\x03\x8d˃?\xe1?\x80\xb0\x1e\"MS\x90\xed\xa3y\xef\x00\xe2:\xb7;\x80w=\x14&\x914x\xa8l\xd9;r\xc5\xcf\x01\xd9\f\xdaY\x02\x9dl\x16\x01\x96\xb4\xdd\t:\x17\x1d\xb9\x13\xc7]\xf4\x92\x1b\x9c\xbbG\x9c\x8b\x82\x8e \x80\xfbp\xfb8J\x7f\xc9\xc54\x85\xc6O\nv\xcc\xec8\xe6F~\x98\xc5\xca\xc0\x85#p\x01\x03\x04\xc7l.hG\u0379\x10\xc2vV\am\x8c!\\#:C\x19h\xa5\x17W7In\x13\xbd\xe3\x11\xd1G\x14\xbe&:\x11\xc5\x18\xf8S+\x8a\x18\xe9\xbdD\xa7\xc0\x1fiQ\xccx\\X$\x9a\xf2\x88\x7f\x01PK\x03\x04\n\x00\x00\b\b\x00\xf5:\x84B\xf6\x84\x8e\xc2G\x00\x00\x00E\x00\x00\x00\x1f\x00\x00\x00gradle-cli-classpath.propertiesS\xce\xcdO)\xcdIUHIM\xcb\xcc\xcb,\xc9\xcc\xcf\xe3R\x0e\xc9(Up,(R00Q00\xb722\xb621Vpv\r\x0eQ0204\xe6*(\xca\xcfJM.)\xb6\xe5**\xcd+\xc9\xccM\xb5\xe5\x02\x00PK\x01\x02\x14\x03\n\x00\x00\b\b\x00\r;\x84B\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\t\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\xedA\x00\x00\x00\x00META-INF/PK\x01\x02\x14\x03\n\x00\x00\b\b\x00\r;\x84BӸ\xe0\x11N\x00\x00\x00h\x00\x00\x00\x14\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81)\x00\x00\x00META-INF/MANIFEST.MFPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84B\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x04\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\xedA\xa9\x00\x00\x00org/PK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84B\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\v\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\xedA\xcd\x00\x00\x00org/gradle/PK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84B\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x13\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\xedA\xf8\x00\x00\x00org/gradle/wrapper/PK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84Bh\x82df\xa3\x00\x00\x00\xd5\x00\x00\x00#\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81+\x01\x00\x00org/gradle/wrapper/Download$1.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84B\n\xbc\u0380\x16\x02\x00\x00p\x04\x00\x00D\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x0f\x02\x00\x00org/gradle/wrapper/Download$SystemPropertiesProxyAuthenticator.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84B\xe7\xecXs\xaa\x00\x00\x00\xdb\x00\x00\x00\"\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x87\x04\x00\x00org/gradle/wrapper/IDownload.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84B\xdbHE*\x05\x06\x00\x00P\v\x00\x003\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81q\x05\x00\x00org/gradle/wrapper/ExclusiveFileAccessManager.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84Bc\xaf\x81\xba`\x02\x00\x00\x10\x06\x00\x00-\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xc7\v\x00\x00org/gradle/wrapper/WrapperConfiguration.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84B\x7f!\xa6\xac\xe6\x04\x00\x00\x19\n\x00\x000\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81r\x0e\x00\x00org/gradle/wrapper/SystemPropertiesHandler.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84B+Nm\t\x1a\a\x00\x00q\x0e\x00\x00&\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa6\x13\x00\x00org/gradle/wrapper/PathAssembler.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84B\xe0\xb7(/\xf2\x0e\x00\x00\x9d \x00\x00 \x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x04\x1b\x00\x00org/gradle/wrapper/Install.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84BG\xdc\x1a\xc4\xc2\x04\x00\x00\x95\t\x00\x00-\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x814*\x00\x00org/gradle/wrapper/BootstrapMainStarter.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84Bf[\xdb\xf1A\n\x00\x00\xb5\x16\x00\x00(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81A/\x00\x00org/gradle/wrapper/WrapperExecutor.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84B{w`~%\n\x00\x00\x0e\x16\x00\x00*\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xc89\x00\x00org/gradle/wrapper/GradleWrapperMain.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84BO\xdd6\x04\x16\x06\x00\x00\xd1\f\x00\x00\"\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x815D\x00\x00org/gradle/wrapper/Install$1.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84B\x1a\x88\x80\xfc\xb2\x01\x00\x00V\x03\x00\x008\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x8bJ\x00\x00org/gradle/wrapper/PathAssembler$LocalDistribution.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84BI}\xc8#8\a\x00\x00z\r\x00\x00!\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x93L\x00\x00org/gradle/wrapper/Download.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\v;\x84BU\tHPQ\x00\x00\x00O\x00\x00\x00#\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\nT\x00\x00gradle-wrapper-classpath.propertiesPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\x88$x\x14\xca\x00\x00\x00\x1d\x01\x00\x00\x18\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x9cT\x00\x00build-receipt.propertiesPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x0f\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00\xedA\x9cU\x00\x00org/gradle/cli/PK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\x88\xee\xf2`\xe7\x02\x00\x00\x90\a\x00\x001\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xcbU\x00\x00org/gradle/cli/AbstractCommandLineConverter.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B2_e\x8f\xa6\x00\x00\x00\xe8\x00\x00\x00(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x01Y\x00\x00org/gradle/cli/CommandLineParser$1.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\n\x82\xbb\xaa\xa1\x02\x00\x00\xf7\x06\x00\x00<\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xedY\x00\x00org/gradle/cli/CommandLineParser$MissingOptionArgState.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\x1e\x83\n\x97\x93\x02\x00\x00\x83\x05\x00\x00=\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe8\\\x00\x00org/gradle/cli/CommandLineParser$OptionStringComparator.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\x97\x9b\xc8\xcbF\x01\x00\x00K\x02\x00\x001\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xd6_\x00\x00org/gradle/cli/CommandLineArgumentException.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84BlZ5\xb6\x84\a\x00\x00\xfb\x12\x00\x00=\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81ka\x00\x00org/gradle/cli/CommandLineParser$KnownOptionParserState.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\xff\x90|\x10\xd3\x02\x00\x00\xdb\x06\x00\x007\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81Ji\x00\x00org/gradle/cli/CommandLineParser$OptionComparator.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84Bသy\xdf\x02\x00\x00n\a\x00\x00?\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81rl\x00\x00org/gradle/cli/CommandLineParser$UnknownOptionParserState.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84BI\xfc\x8b\xc5\xfa\x04\x00\x00\x93\v\x00\x00&\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xaeo\x00\x00org/gradle/cli/CommandLineOption.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\xfa\xd6\xd0>\xa6\x01\x00\x00\xa6\x03\x00\x008\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xect\x00\x00org/gradle/cli/CommandLineParser$OptionParserState.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\x83\x94\x86\xb5\xf4\x06\x00\x00\x16\x10\x00\x00&\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe8v\x00\x00org/gradle/cli/ParsedCommandLine.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\x8bA5l|\x01\x00\x00\v\x03\x00\x00:\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81 ~\x00\x00org/gradle/cli/ProjectPropertiesCommandLineConverter.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B1\f\xa9\xabP\x02\x00\x00\x04\x05\x00\x00F\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf4\x7f\x00\x00org/gradle/cli/CommandLineParser$CaseInsensitiveStringComparator.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\xa2V\x98\"\x97\x10\x00\x00\xdc&\x00\x00&\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa8\x82\x00\x00org/gradle/cli/CommandLineParser.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\x90`\xf7ť\x02\x00\x00)\a\x00\x003\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x83\x93\x00\x00org/gradle/cli/CommandLineParser$AfterOptions.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84BQ\xc6b\x8c\x97\x02\x00\x00\x9c\x05\x00\x003\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81y\x96\x00\x00org/gradle/cli/CommandLineParser$OptionString.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\xfd\xb9E#\xb1\x04\x00\x00!\f\x00\x00;\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81a\x99\x00\x00org/gradle/cli/AbstractPropertiesCommandLineConverter.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\x93\x9d\xbc\x15\xbc\x02\x00\x00 \x05\x00\x00,\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81k\x9e\x00\x00org/gradle/cli/ParsedCommandLineOption.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B7Ɋ\xaf\x99\x02\x00\x00\xaa\x06\x00\x00=\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81q\xa1\x00\x00org/gradle/cli/CommandLineParser$OptionAwareParserState.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B$\xb8R\xfc<\x01\x00\x00Y\x03\x00\x00)\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81e\xa4\x00\x00org/gradle/cli/CommandLineConverter.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B@ͤ1\xe9\x03\x00\x00|\v\x00\x00<\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe8\xa5\x00\x00org/gradle/cli/CommandLineParser$BeforeFirstSubCommand.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\x93\x00;\x99|\x01\x00\x00\xfc\x02\x00\x009\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81+\xaa\x00\x00org/gradle/cli/SystemPropertiesCommandLineConverter.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\x9c\xb3I\x7f\x03\x02\x00\x00\xb7\x04\x00\x002\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xfe\xab\x00\x00org/gradle/cli/CommandLineParser$ParserState.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\x8f6\x9c7<\x03\x00\x00\xca\t\x00\x00;\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81Q\xae\x00\x00org/gradle/cli/CommandLineParser$AfterFirstSubCommand.classPK\x01\x02\x14\x03\n\x00\x00\b\b\x00\xf5:\x84B\xf6\x84\x8e\xc2G\x00\x00\x00E\x00\x00\x00\x1f\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe6\xb1\x00\x00gradle-cli-classpath.propertiesPK\x05\x06\x00\x00\x00\x00/\x00/\x00h\x10\x00\x00j\xb2\x00\x00\x00\x00",
+}
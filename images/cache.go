@@ -0,0 +1,205 @@
+package images
+
+import (
+	"container/list"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// lru is a least recently used cache bounded by the total size of its
+// values. onEvict, if set, is called for each evicted key.
+type lru struct {
+	max     int64
+	size    int64
+	ll      *list.List
+	items   map[string]*list.Element
+	onEvict func(key string)
+	sync.Mutex
+}
+
+type lruEntry struct {
+	key  string
+	val  []byte
+	size int64
+}
+
+func newLRU(max int64, onEvict func(string)) *lru {
+	return &lru{
+		max:     max,
+		ll:      list.New(),
+		items:   make(map[string]*list.Element),
+		onEvict: onEvict,
+	}
+}
+
+func (c *lru) get(key string) ([]byte, bool) {
+	c.Lock()
+	defer c.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(e)
+	return e.Value.(*lruEntry).val, true
+}
+
+// add stores val under key. Values larger than the cache are not stored.
+func (c *lru) add(key string, val []byte) {
+	c.addSized(key, val, int64(len(val)))
+}
+
+// addSized stores val under key counting size against the bound of the cache
+// instead of the length of val. diskCache uses it to index files without
+// holding their contents.
+func (c *lru) addSized(key string, val []byte, size int64) {
+	c.Lock()
+	defer c.Unlock()
+	if size > c.max {
+		return
+	}
+	if e, ok := c.items[key]; ok {
+		c.ll.MoveToFront(e)
+		c.size += size - e.Value.(*lruEntry).size
+		e.Value = &lruEntry{key, val, size}
+	} else {
+		c.items[key] = c.ll.PushFront(&lruEntry{key, val, size})
+		c.size += size
+	}
+	for c.size > c.max {
+		e := c.ll.Back()
+		ent := e.Value.(*lruEntry)
+		c.ll.Remove(e)
+		delete(c.items, ent.key)
+		c.size -= ent.size
+		if c.onEvict != nil {
+			c.onEvict(ent.key)
+		}
+	}
+}
+
+// flight runs a function only once for concurrent calls with the same key so
+// that simultaneous requests for an uncached variant transform the image once
+type flight struct {
+	calls map[string]*flightCall
+	sync.Mutex
+}
+
+type flightCall struct {
+	wg  sync.WaitGroup
+	val []byte
+	err error
+}
+
+// do returns the result of fn, waiting for the call already in flight for key
+// if there is one
+func (f *flight) do(key string, fn func() ([]byte, error)) ([]byte, error) {
+	f.Lock()
+	if f.calls == nil {
+		f.calls = make(map[string]*flightCall)
+	}
+	if c, ok := f.calls[key]; ok {
+		f.Unlock()
+		c.wg.Wait()
+		return c.val, c.err
+	}
+	c := &flightCall{}
+	c.wg.Add(1)
+	f.calls[key] = c
+	f.Unlock()
+
+	c.val, c.err = fn()
+	c.wg.Done()
+
+	f.Lock()
+	delete(f.calls, key)
+	f.Unlock()
+	return c.val, c.err
+}
+
+// diskCache is an LRU cache of files in a folder. The index of files and
+// their sizes is kept in memory and rebuilt from the folder on startup in the
+// order of the modification times which are updated on every hit.
+type diskCache struct {
+	dir   string
+	index *lru
+}
+
+// diskCacheExt marks files written by diskCache so that other files in the
+// folder are left alone
+const diskCacheExt = ".imgcache"
+
+func newDiskCache(dir string, max int64) (*diskCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("could not create image cache folder %s: %s", dir, err)
+	}
+	d := &diskCache{dir: dir}
+	d.index = newLRU(max, func(key string) {
+		os.Remove(d.path(key))
+	})
+
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read image cache folder %s: %s", dir, err)
+	}
+	sort.Sort(byModTime(fis))
+	for _, fi := range fis {
+		if fi.IsDir() || !strings.HasSuffix(fi.Name(), diskCacheExt) {
+			continue
+		}
+		d.index.addSized(strings.TrimSuffix(fi.Name(), diskCacheExt), nil, fi.Size())
+	}
+	return d, nil
+}
+
+func (d *diskCache) path(key string) string {
+	return filepath.Join(d.dir, key+diskCacheExt)
+}
+
+func (d *diskCache) get(key string) ([]byte, bool) {
+	if _, ok := d.index.get(key); !ok {
+		return nil, false
+	}
+	b, err := ioutil.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+	now := time.Now()
+	os.Chtimes(d.path(key), now, now)
+	return b, true
+}
+
+// add writes val to a temporary file first and renames it so that readers
+// never see a partially written file
+func (d *diskCache) add(key string, val []byte) error {
+	tmp, err := ioutil.TempFile(d.dir, "tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(val); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), d.path(key)); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	d.index.addSized(key, nil, int64(len(val)))
+	return nil
+}
+
+type byModTime []os.FileInfo
+
+func (s byModTime) Len() int           { return len(s) }
+func (s byModTime) Less(i, j int) bool { return s[i].ModTime().Before(s[j].ModTime()) }
+func (s byModTime) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
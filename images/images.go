@@ -0,0 +1,282 @@
+// Package images serves resized and cropped variants of images for webapps,
+// for instance to fit a background image to the screen of the device.
+//
+// Source images are read from an http.FileSystem such as assets.Bindata for
+// embedded images or http.Dir for images on disk. Requested dimensions are
+// bounded to protect the limited memory of mobile devices and encoded
+// variants are kept in an in-memory and optionally an on-disk LRU cache so
+// that each variant is only computed once. Responses carry an ETag derived
+// from the source content and the transformation so that the WebView can
+// revalidate cached images cheaply.
+package images
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif" // GIF sources are decoded but variants are encoded as PNG
+	"image/jpeg"
+	"image/png"
+	"log"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/disintegration/imaging"
+	"github.com/srinathh/mobilehtml5app/assets"
+	"github.com/srinathh/mobilehtml5app/contextrouter"
+	"golang.org/x/net/context"
+)
+
+// Mode denotes how an image is transformed to the requested dimensions
+type Mode string
+
+// Supported transformation modes
+const (
+	// Fit scales the image to fit within the requested dimensions preserving
+	// the aspect ratio. The result may be smaller than requested in one
+	// dimension.
+	Fit Mode = "fit"
+	// Fill scales the image to cover the requested dimensions preserving the
+	// aspect ratio and crops the center to exactly the requested dimensions.
+	Fill Mode = "fill"
+	// Crop cuts out the center of the image at the requested dimensions
+	// without scaling.
+	Crop Mode = "crop"
+)
+
+// Defaults used for zero valued Options
+const (
+	DefaultMaxDimension = 2048
+	DefaultQuality      = 80
+	DefaultMemoryBytes  = 8 << 20
+	DefaultDiskBytes    = 32 << 20
+)
+
+// Options configures a Server. Zero values select the defaults.
+type Options struct {
+	// MaxWidth and MaxHeight bound the requested dimensions. Requests for
+	// larger images are rejected with a 400 Bad Request.
+	MaxWidth, MaxHeight int
+	// Quality is the JPEG quality used to encode JPEG sources.
+	Quality int
+	// MemoryBytes bounds the size of the in-memory cache of encoded images.
+	MemoryBytes int64
+	// CacheDir is a folder such as the app's cache folder used for the
+	// on-disk cache of encoded images. The on-disk cache is disabled if empty.
+	CacheDir string
+	// DiskBytes bounds the size of the on-disk cache.
+	DiskBytes int64
+	// Hashes caches the content hashes of the source images used for ETags.
+	// Pass Fingerprinter.Hashes() of a Fingerprinter serving the same file
+	// system so that each file is only hashed once. A new cache is used if
+	// nil.
+	Hashes *assets.HashCache
+}
+
+// Server serves transformed variants of the images in a file system.
+type Server struct {
+	fs     http.FileSystem
+	opts   Options
+	mem    *lru
+	disk   *diskCache
+	hashes *assets.HashCache
+	// inflight dedupes transforms of the same uncached variant
+	inflight flight
+}
+
+// New returns a Server for the images in fs.
+func New(fs http.FileSystem, opts Options) (*Server, error) {
+	if opts.MaxWidth <= 0 {
+		opts.MaxWidth = DefaultMaxDimension
+	}
+	if opts.MaxHeight <= 0 {
+		opts.MaxHeight = DefaultMaxDimension
+	}
+	if opts.Quality <= 0 || opts.Quality > 100 {
+		opts.Quality = DefaultQuality
+	}
+	if opts.MemoryBytes <= 0 {
+		opts.MemoryBytes = DefaultMemoryBytes
+	}
+	if opts.DiskBytes <= 0 {
+		opts.DiskBytes = DefaultDiskBytes
+	}
+	if opts.Hashes == nil {
+		opts.Hashes = assets.NewHashCache(fs)
+	}
+
+	s := &Server{
+		fs:     fs,
+		opts:   opts,
+		mem:    newLRU(opts.MemoryBytes, nil),
+		hashes: opts.Hashes,
+	}
+	if opts.CacheDir != "" {
+		disk, err := newDiskCache(opts.CacheDir, opts.DiskBytes)
+		if err != nil {
+			return nil, err
+		}
+		s.disk = disk
+	}
+	return s, nil
+}
+
+// ServeHTTP serves the image named by the filepath routing parameter at the
+// dimensions given by the width and height routing parameters. The mode is
+// taken from the mode query parameter and defaults to Fill.
+//
+//	srv.Router.Handle(contextrouter.GET, "/img/:width/:height/*filepath", imgsrv)
+func (s *Server) ServeHTTP(c context.Context, w http.ResponseWriter, r *http.Request) {
+	mode := Mode(r.URL.Query().Get("mode"))
+	if mode == "" {
+		mode = Fill
+	}
+	s.serve(c, w, r, c.Value("filepath").(string), mode)
+}
+
+// Image returns a ContextHandler serving the named image transformed with
+// mode at the dimensions given by the width and height routing parameters.
+//
+//	srv.Router.Handle(contextrouter.GET, "/bg/:width/:height", imgsrv.Image("img/bg.jpg", images.Fill))
+func (s *Server) Image(name string, mode Mode) contextrouter.ContextHandler {
+	return contextrouter.ContextHandlerFunc(func(c context.Context, w http.ResponseWriter, r *http.Request) {
+		s.serve(c, w, r, name, mode)
+	})
+}
+
+func (s *Server) serve(c context.Context, w http.ResponseWriter, r *http.Request, name string, mode Mode) {
+	name = path.Clean("/" + name)
+
+	width, err := s.dimension(c, "width", s.opts.MaxWidth)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	height, err := s.dimension(c, "height", s.opts.MaxHeight)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if mode != Fit && mode != Fill && mode != Crop {
+		http.Error(w, fmt.Sprintf("mode must be one of fit, fill or crop. Got %s", mode), http.StatusBadRequest)
+		return
+	}
+
+	srchash, err := s.hash(name)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	key := fmt.Sprintf("%s-%s-%dx%d-q%d", srchash, mode, width, height, s.opts.Quality)
+	etag := `"` + key + `"`
+
+	// answer revalidations without touching the caches
+	if match := r.Header.Get("If-None-Match"); match != "" && strings.Contains(match, etag) {
+		w.Header().Set("ETag", etag)
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	b, err := s.variant(key, name, mode, width, height)
+	if err != nil {
+		log.Printf("images: could not transform %s: %s", name, err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	http.ServeContent(w, r, path.Base(name), time.Time{}, bytes.NewReader(b))
+}
+
+// dimension parses and bounds the named routing parameter
+func (s *Server) dimension(c context.Context, param string, max int) (int, error) {
+	v, _ := c.Value(param).(string)
+	d, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("could not decode %s %q: %s", param, v, err)
+	}
+	if d < 1 || d > max {
+		return 0, fmt.Errorf("%s must be between 1 and %d. Got %d", param, max, d)
+	}
+	return d, nil
+}
+
+// variant returns the encoded image for key from the caches, computing and
+// caching it if necessary
+func (s *Server) variant(key, name string, mode Mode, width, height int) ([]byte, error) {
+	if b, ok := s.mem.get(key); ok {
+		return b, nil
+	}
+	if s.disk != nil {
+		if b, ok := s.disk.get(key); ok {
+			s.mem.add(key, b)
+			return b, nil
+		}
+	}
+
+	return s.inflight.do(key, func() ([]byte, error) {
+		// a transform that just finished may have added key after the
+		// lookup above
+		if b, ok := s.mem.get(key); ok {
+			return b, nil
+		}
+		b, err := s.transform(name, mode, width, height)
+		if err != nil {
+			return nil, err
+		}
+		s.mem.add(key, b)
+		if s.disk != nil {
+			if err := s.disk.add(key, b); err != nil {
+				log.Printf("images: could not cache %s on disk: %s", name, err)
+			}
+		}
+		return b, nil
+	})
+}
+
+// transform decodes, transforms and encodes the named image
+func (s *Server) transform(name string, mode Mode, width, height int) ([]byte, error) {
+	f, err := s.fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	src, format, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode: %s", err)
+	}
+
+	var dst image.Image
+	switch mode {
+	case Fit:
+		dst = imaging.Fit(src, width, height, imaging.Lanczos)
+	case Fill:
+		dst = imaging.Fill(src, width, height, imaging.Center, imaging.Lanczos)
+	case Crop:
+		dst = imaging.CropCenter(src, width, height)
+	}
+
+	buf := bytes.Buffer{}
+	if format == "jpeg" {
+		err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: s.opts.Quality})
+	} else {
+		err = png.Encode(&buf, dst)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not encode: %s", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// hash returns the content hash of the named source image
+func (s *Server) hash(name string) (string, error) {
+	hash, err := s.hashes.Hash(name)
+	if err != nil {
+		return "", err
+	}
+	return hash[:16], nil
+}
@@ -0,0 +1,228 @@
+package images
+
+import (
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/srinathh/mobilehtml5app/contextrouter"
+)
+
+// writeTestImages writes a 400x200 JPEG and a 100x100 PNG into a temporary
+// folder and returns its path
+func writeTestImages(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "images_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	write := func(name string, w, h int, enc func(*os.File, image.Image) error) {
+		img := image.NewRGBA(image.Rect(0, 0, w, h))
+		for x := 0; x < w; x++ {
+			for y := 0; y < h; y++ {
+				img.Set(x, y, color.RGBA{uint8(x), uint8(y), 128, 255})
+			}
+		}
+		f, err := os.Create(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		if err := enc(f, img); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("wide.jpg", 400, 200, func(f *os.File, img image.Image) error { return jpeg.Encode(f, img, nil) })
+	write("square.png", 100, 100, func(f *os.File, img image.Image) error { return png.Encode(f, img) })
+	return dir
+}
+
+func newTestServer(t *testing.T, s *Server) *httptest.Server {
+	router := contextrouter.New()
+	router.Handle(contextrouter.GET, "/img/:width/:height/*filepath", s)
+	router.Handle(contextrouter.GET, "/bg/:width/:height", s.Image("wide.jpg", Fill))
+	return httptest.NewServer(router)
+}
+
+func fetchImage(t *testing.T, url, etag string) (*http.Response, image.Image) {
+	req, _ := http.NewRequest("GET", url, nil)
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("could not fetch from %s: %s", url, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return res, nil
+	}
+	img, _, err := image.Decode(res.Body)
+	if err != nil {
+		t.Fatalf("could not decode image from %s: %s", url, err)
+	}
+	return res, img
+}
+
+func TestTransform(t *testing.T) {
+	dir := writeTestImages(t)
+	defer os.RemoveAll(dir)
+
+	s, err := New(http.Dir(dir), Options{MaxWidth: 1000, MaxHeight: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := newTestServer(t, s)
+	defer ts.Close()
+
+	tests := []struct {
+		path          string
+		code          int
+		width, height int
+		contentType   string
+	}{
+		{"/img/100/100/wide.jpg", http.StatusOK, 100, 100, "image/jpeg"},
+		{"/img/100/100/wide.jpg?mode=fit", http.StatusOK, 100, 50, "image/jpeg"},
+		{"/img/50/60/wide.jpg?mode=crop", http.StatusOK, 50, 60, "image/jpeg"},
+		{"/img/30/20/square.png", http.StatusOK, 30, 20, "image/png"},
+		{"/bg/120/80", http.StatusOK, 120, 80, "image/jpeg"},
+		{"/img/100/100/wide.jpg?mode=stretch", http.StatusBadRequest, 0, 0, ""},
+		{"/img/0/100/wide.jpg", http.StatusBadRequest, 0, 0, ""},
+		{"/img/100/1001/wide.jpg", http.StatusBadRequest, 0, 0, ""},
+		{"/img/abc/100/wide.jpg", http.StatusBadRequest, 0, 0, ""},
+		{"/img/100/100/missing.jpg", http.StatusNotFound, 0, 0, ""},
+	}
+	for _, test := range tests {
+		res, img := fetchImage(t, ts.URL+test.path, "")
+		if res.StatusCode != test.code {
+			t.Errorf("%s: want status %d got %d", test.path, test.code, res.StatusCode)
+			continue
+		}
+		if test.code != http.StatusOK {
+			continue
+		}
+		if b := img.Bounds(); b.Dx() != test.width || b.Dy() != test.height {
+			t.Errorf("%s: want %dx%d got %dx%d", test.path, test.width, test.height, b.Dx(), b.Dy())
+		}
+		if ct := res.Header.Get("Content-Type"); ct != test.contentType {
+			t.Errorf("%s: want Content-Type %s got %s", test.path, test.contentType, ct)
+		}
+	}
+}
+
+func TestETag(t *testing.T) {
+	dir := writeTestImages(t)
+	defer os.RemoveAll(dir)
+
+	cachedir := filepath.Join(dir, "cache")
+	s, err := New(http.Dir(dir), Options{CacheDir: cachedir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := newTestServer(t, s)
+	defer ts.Close()
+
+	res, _ := fetchImage(t, ts.URL+"/bg/64/48", "")
+	etag := res.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("no ETag in response")
+	}
+	if res, _ := fetchImage(t, ts.URL+"/bg/64/48", ""); res.Header.Get("ETag") != etag {
+		t.Errorf("ETag changed between requests: %s %s", etag, res.Header.Get("ETag"))
+	}
+	if res, _ := fetchImage(t, ts.URL+"/bg/64/48", etag); res.StatusCode != http.StatusNotModified {
+		t.Errorf("want status %d for matching ETag got %d", http.StatusNotModified, res.StatusCode)
+	}
+	if res, _ := fetchImage(t, ts.URL+"/bg/64/49", ""); res.Header.Get("ETag") == etag {
+		t.Errorf("ETag did not change with dimensions")
+	}
+
+	fis, err := ioutil.ReadDir(cachedir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cached := 0
+	for _, fi := range fis {
+		if strings.HasSuffix(fi.Name(), diskCacheExt) {
+			cached++
+		}
+	}
+	if cached != 2 {
+		t.Errorf("want 2 images cached on disk got %d", cached)
+	}
+
+	// a new server picks up the on-disk cache
+	s2, err := New(http.Dir(dir), Options{CacheDir: cachedir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s2.disk.get(strings.Trim(etag, `"`)); !ok {
+		t.Errorf("image cached on disk not found by a new server")
+	}
+}
+
+func TestLRU(t *testing.T) {
+	var evicted []string
+	c := newLRU(10, func(key string) { evicted = append(evicted, key) })
+	c.add("a", []byte("aaaa"))
+	c.add("b", []byte("bbbb"))
+	c.get("a")
+	c.add("c", []byte("cccc"))
+	if _, ok := c.get("b"); ok {
+		t.Error("least recently used entry b not evicted")
+	}
+	if _, ok := c.get("a"); !ok {
+		t.Error("recently used entry a evicted")
+	}
+	if len(evicted) != 1 || evicted[0] != "b" {
+		t.Errorf("want b evicted got %v", evicted)
+	}
+	c.add("big", make([]byte, 11))
+	if _, ok := c.get("big"); ok {
+		t.Error("entry larger than the cache stored")
+	}
+}
+
+func TestFlight(t *testing.T) {
+	var f flight
+	var calls int32
+	release := make(chan struct{})
+	fn := func() ([]byte, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return []byte("resized"), nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([][]byte, 5)
+	for j := range results {
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			results[j], _ = f.do("key", fn)
+		}(j)
+	}
+	// let the goroutines join the call in flight before it returns
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("want 1 call got %d", n)
+	}
+	for j, b := range results {
+		if string(b) != "resized" {
+			t.Errorf("result %d: want resized got %q", j, b)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package native
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+)
+
+// Fake is an in-memory Platform recording the calls made to it for testing
+// code using the Platform on the desktop. Lock the Fake before reading its
+// fields while calls may still be made.
+type Fake struct {
+	// Toasts, Shares, Vibrations and Opened record the arguments of calls
+	Toasts     []FakeToast
+	Shares     []FakeShare
+	Vibrations []int
+	Opened     []string
+	// Text is the contents of the clipboard
+	Text string
+	// Err is returned by all methods returning an error if set
+	Err error
+	sync.Mutex
+}
+
+// FakeToast records a call to Fake.Toast
+type FakeToast struct {
+	Text string
+	Long bool
+}
+
+// FakeShare records a call to Fake.Share
+type FakeShare struct {
+	Subject, Text string
+}
+
+// Toast implements Toaster
+func (f *Fake) Toast(text string, long bool) {
+	f.Lock()
+	defer f.Unlock()
+	f.Toasts = append(f.Toasts, FakeToast{text, long})
+}
+
+// Share implements Sharer
+func (f *Fake) Share(subject, text string) error {
+	f.Lock()
+	defer f.Unlock()
+	if f.Err != nil {
+		return f.Err
+	}
+	f.Shares = append(f.Shares, FakeShare{subject, text})
+	return nil
+}
+
+// Vibrate implements Vibrator
+func (f *Fake) Vibrate(millis int) error {
+	f.Lock()
+	defer f.Unlock()
+	if f.Err != nil {
+		return f.Err
+	}
+	f.Vibrations = append(f.Vibrations, millis)
+	return nil
+}
+
+// OpenURL implements URLOpener
+func (f *Fake) OpenURL(rawurl string) error {
+	f.Lock()
+	defer f.Unlock()
+	if f.Err != nil {
+		return f.Err
+	}
+	f.Opened = append(f.Opened, rawurl)
+	return nil
+}
+
+// ClipboardText implements Clipboard
+func (f *Fake) ClipboardText() (string, error) {
+	f.Lock()
+	defer f.Unlock()
+	if f.Err != nil {
+		return "", f.Err
+	}
+	return f.Text, nil
+}
+
+// SetClipboardText implements Clipboard
+func (f *Fake) SetClipboardText(text string) error {
+	f.Lock()
+	defer f.Unlock()
+	if f.Err != nil {
+		return f.Err
+	}
+	f.Text = text
+	return nil
+}
+
+// FakeWebView is an in-memory WebView recording the commands sent to it as
+// "navigate <url>", "reload" and "eval <snippet>"
+type FakeWebView struct {
+	Commands []string
+	sync.Mutex
+}
+
+// Navigate implements WebView
+func (f *FakeWebView) Navigate(rawurl string) {
+	f.record("navigate " + rawurl)
+}
+
+// Reload implements WebView
+func (f *FakeWebView) Reload() {
+	f.record("reload")
+}
+
+// EvaluateJavascript implements WebView
+func (f *FakeWebView) EvaluateJavascript(snippet string) {
+	f.record("eval " + snippet)
+}
+
+func (f *FakeWebView) record(cmd string) {
+	f.Lock()
+	defer f.Unlock()
+	f.Commands = append(f.Commands, cmd)
+}
+
+// FakeShareSource shares content into a ShareReceiver like the native
+// portion of the app does for SEND intents, passing files as temporary copies
+type FakeShareSource struct {
+	Receiver *ShareReceiver
+}
+
+// FakeFile is a file shared by FakeShareSource
+type FakeFile struct {
+	Name, MimeType string
+	Data           []byte
+}
+
+// Send shares subject, text and files and returns the ID of the share.
+func (f *FakeShareSource) Send(subject, text string, files ...FakeFile) (string, error) {
+	id := f.Receiver.Begin(subject, text)
+	for _, file := range files {
+		if err := f.addFile(id, file); err != nil {
+			f.Receiver.Abort(id)
+			return "", err
+		}
+	}
+	return id, f.Receiver.Commit(id)
+}
+
+func (f *FakeShareSource) addFile(id string, file FakeFile) error {
+	tmp, err := ioutil.TempFile("", "share")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(file.Data)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return f.Receiver.AddFile(id, tmp.Name(), file.Name, file.MimeType)
+}
@@ -0,0 +1,264 @@
+// Package native lets the Go backend and the Javascript frontend of a webapp
+// use features of the mobile platform that are not available to a WebView,
+// such as toasts, the share sheet, vibration, opening URLs in external apps
+// and the clipboard.
+//
+// The features are declared as Go interfaces which the native portion of
+// the app implements in Java through gomobile. Bind this package along with
+// the webapp package, implement native.Platform in Java and pass it to the
+// App, for instance through a method like:
+//
+//	// SetPlatform is called by the native portion of the app
+//	func (app *App) SetPlatform(p native.Platform) {
+//		native.Mount(app.srv.Router, p)
+//	}
+//
+// Mount exposes the Platform to Javascript through routes under Path. A small
+// Javascript helper served at ScriptPath defines a global native object
+// with a function per route returning a Promise:
+//
+//	<script src="/_native/native.js"></script>
+//	native.share("Todo", "Buy milk").catch(function(err){ ... });
+//
+// Commander lets the backend navigate, reload or run scripts in the WebView
+// and ShareReceiver receives content shared into the app by other apps.
+//
+// Handlers that call the Platform from Go can be tested on the desktop with
+// Fake, FakeWebView and FakeShareSource.
+package native
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+
+	"github.com/srinathh/mobilehtml5app/contextrouter"
+	"golang.org/x/net/context"
+)
+
+// Toaster shows short messages to the user
+type Toaster interface {
+	// Toast shows text for a short or, if long is true, a long duration
+	Toast(text string, long bool)
+}
+
+// Sharer sends content to other apps
+type Sharer interface {
+	// Share opens the share sheet for text with an optional subject
+	Share(subject, text string) error
+}
+
+// Vibrator vibrates the device
+type Vibrator interface {
+	// Vibrate vibrates the device for millis milliseconds
+	Vibrate(millis int) error
+}
+
+// URLOpener opens URLs in other apps
+type URLOpener interface {
+	// OpenURL opens rawurl in the app registered for it such as the browser
+	OpenURL(rawurl string) error
+}
+
+// Clipboard reads and writes the text on the system clipboard
+type Clipboard interface {
+	ClipboardText() (string, error)
+	SetClipboardText(text string) error
+}
+
+// Platform is implemented by the native portion of the app. The methods are
+// restricted to types supported by gomobile.
+type Platform interface {
+	Toaster
+	Sharer
+	Vibrator
+	URLOpener
+	Clipboard
+}
+
+// Routes registered by Mount
+const (
+	Path       = "/_native"
+	ScriptPath = Path + "/native.js"
+)
+
+// MaxVibrate bounds the duration of vibrations requested from Javascript
+const MaxVibrate = 5000
+
+// openSchemes are the URL schemes Javascript is allowed to open since other
+// schemes could launch arbitrary intents
+var openSchemes = map[string]bool{
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+	"tel":    true,
+	"sms":    true,
+	"geo":    true,
+}
+
+// Mount registers routes calling p on router. All routes take and return
+// JSON. Successful calls without a result respond with 204 No Content.
+// Requests with a Content-Type other than application/json are rejected
+// with 415 Unsupported Media Type so that other web pages need a CORS
+// preflight, which the server does not answer, to reach the Platform.
+//
+//	POST /_native/toast      {"text": string, "long": bool}
+//	POST /_native/share      {"subject": string, "text": string}
+//	POST /_native/vibrate    {"millis": int}
+//	POST /_native/open       {"url": string}
+//	GET  /_native/clipboard  returns {"text": string}
+//	POST /_native/clipboard  {"text": string}
+func Mount(router *contextrouter.ContextRouter, p Platform) {
+	router.HandleFunc(contextrouter.GET, ScriptPath, serveScript)
+
+	router.HandleFunc(contextrouter.POST, Path+"/toast", func(c context.Context, w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Text string `json:"text"`
+			Long bool   `json:"long"`
+		}
+		if decode(w, r, &req) {
+			p.Toast(req.Text, req.Long)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	router.HandleFunc(contextrouter.POST, Path+"/share", func(c context.Context, w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Subject string `json:"subject"`
+			Text    string `json:"text"`
+		}
+		if decode(w, r, &req) {
+			respond(w, "share", p.Share(req.Subject, req.Text), nil)
+		}
+	})
+
+	router.HandleFunc(contextrouter.POST, Path+"/vibrate", func(c context.Context, w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Millis int `json:"millis"`
+		}
+		if !decode(w, r, &req) {
+			return
+		}
+		if req.Millis < 1 || req.Millis > MaxVibrate {
+			http.Error(w, fmt.Sprintf("millis must be between 1 and %d. Got %d", MaxVibrate, req.Millis), http.StatusBadRequest)
+			return
+		}
+		respond(w, "vibrate", p.Vibrate(req.Millis), nil)
+	})
+
+	router.HandleFunc(contextrouter.POST, Path+"/open", func(c context.Context, w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			URL string `json:"url"`
+		}
+		if !decode(w, r, &req) {
+			return
+		}
+		u, err := url.Parse(req.URL)
+		if err != nil || !openSchemes[u.Scheme] {
+			http.Error(w, fmt.Sprintf("can not open %q", req.URL), http.StatusBadRequest)
+			return
+		}
+		respond(w, "open", p.OpenURL(req.URL), nil)
+	})
+
+	router.HandleFunc(contextrouter.GET, Path+"/clipboard", func(c context.Context, w http.ResponseWriter, r *http.Request) {
+		text, err := p.ClipboardText()
+		respond(w, "clipboard", err, struct {
+			Text string `json:"text"`
+		}{text})
+	})
+
+	router.HandleFunc(contextrouter.POST, Path+"/clipboard", func(c context.Context, w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Text string `json:"text"`
+		}
+		if decode(w, r, &req) {
+			respond(w, "clipboard", p.SetClipboardText(req.Text), nil)
+		}
+	})
+}
+
+// decode decodes the JSON request body into v and responds with 415
+// Unsupported Media Type if the request is not JSON or 400 Bad Request if it
+// can't be decoded
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if mt, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mt != "application/json" {
+		http.Error(w, "Content-Type must be application/json", http.StatusUnsupportedMediaType)
+		return false
+	}
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		http.Error(w, fmt.Sprintf("could not decode request: %s", err), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// respond writes the error returned by the Platform or result as JSON or
+// 204 No Content if result is nil
+func respond(w http.ResponseWriter, feature string, err error, result interface{}) {
+	if err != nil {
+		log.Printf("native: %s failed: %s", feature, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if result == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		log.Printf("native: could not encode %s result: %s", feature, err)
+	}
+}
+
+func serveScript(_ context.Context, w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/javascript")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Write([]byte(script))
+}
+
+const script = `(function(global) {
+    // XMLHttpRequest is used rather than fetch which older Android
+    // WebViews lack
+    function request(method, name, body) {
+        return new Promise(function(resolve, reject) {
+            var xhr = new XMLHttpRequest();
+            xhr.open(method, "` + Path + `/" + name);
+            xhr.onload = function() {
+                if (xhr.status < 200 || xhr.status >= 300) {
+                    reject(new Error(xhr.responseText.trim()));
+                    return;
+                }
+                resolve(xhr.responseText ? JSON.parse(xhr.responseText) : undefined);
+            };
+            xhr.onerror = function() {
+                reject(new Error("native: request failed"));
+            };
+            if (body === undefined) {
+                xhr.send();
+                return;
+            }
+            xhr.setRequestHeader("Content-Type", "application/json");
+            xhr.send(JSON.stringify(body));
+        });
+    }
+
+    function post(name, body) {
+        return request("POST", name, body);
+    }
+
+    global.native = {
+        toast: function(text, long) { return post("toast", {text: text, long: !!long}); },
+        share: function(subject, text) { return post("share", {subject: subject, text: text}); },
+        vibrate: function(millis) { return post("vibrate", {millis: millis}); },
+        open: function(url) { return post("open", {url: url}); },
+        readClipboard: function() {
+            return request("GET", "clipboard").then(function(res) { return res.text; });
+        },
+        writeClipboard: function(text) { return post("clipboard", {text: text}); }
+    };
+})(this);
+`
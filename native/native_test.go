@@ -0,0 +1,265 @@
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srinathh/mobilehtml5app/contextrouter"
+	"github.com/srinathh/mobilehtml5app/sse"
+)
+
+var (
+	_ Platform = &Fake{}
+	_ WebView  = &FakeWebView{}
+	_ WebView  = &Commander{}
+)
+
+func request(t *testing.T, method, url, body string) (int, string) {
+	req, _ := http.NewRequest(method, url, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.StatusCode, strings.TrimSpace(string(b))
+}
+
+func TestMount(t *testing.T) {
+	fake := &Fake{Text: "copied"}
+	router := contextrouter.New()
+	Mount(router, fake)
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+	defer router.Stop()
+
+	tests := []struct {
+		method, path, body string
+		code               int
+		res                string
+	}{
+		{"POST", "/toast", `{"text":"Saved","long":true}`, http.StatusNoContent, ""},
+		{"POST", "/share", `{"subject":"Todo","text":"Buy milk"}`, http.StatusNoContent, ""},
+		{"POST", "/vibrate", `{"millis":200}`, http.StatusNoContent, ""},
+		{"POST", "/vibrate", `{"millis":60000}`, http.StatusBadRequest, "millis must be between 1 and 5000. Got 60000"},
+		{"POST", "/open", `{"url":"https://golang.org"}`, http.StatusNoContent, ""},
+		{"POST", "/open", `{"url":"intent://scan"}`, http.StatusBadRequest, `can not open "intent://scan"`},
+		{"GET", "/clipboard", "", http.StatusOK, `{"text":"copied"}`},
+		{"POST", "/clipboard", `{"text":"pasted"}`, http.StatusNoContent, ""},
+		{"POST", "/toast", `{"text":`, http.StatusBadRequest, "could not decode request: unexpected EOF"},
+	}
+	for _, test := range tests {
+		code, res := request(t, test.method, ts.URL+Path+test.path, test.body)
+		if code != test.code || res != test.res {
+			t.Errorf("%s %s %s: want %d %q got %d %q", test.method, test.path, test.body, test.code, test.res, code, res)
+		}
+	}
+
+	want := &Fake{
+		Toasts:     []FakeToast{{"Saved", true}},
+		Shares:     []FakeShare{{"Todo", "Buy milk"}},
+		Vibrations: []int{200},
+		Opened:     []string{"https://golang.org"},
+		Text:       "pasted",
+	}
+	if !reflect.DeepEqual(fake, want) {
+		t.Errorf("want calls %+v got %+v", want, fake)
+	}
+
+	fake.Err = errors.New("no app to share with")
+	if code, res := request(t, "POST", ts.URL+Path+"/share", `{"text":"Buy milk"}`); code != http.StatusInternalServerError || res != "no app to share with" {
+		t.Errorf("want platform error got %d %q", code, res)
+	}
+
+	// a cross-origin form or text/plain post must not reach the Platform
+	res, err := http.Post(ts.URL+Path+"/toast", "text/plain", strings.NewReader(`{"text":"Hacked"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusUnsupportedMediaType || len(fake.Toasts) != 1 {
+		t.Errorf("text/plain: want status %d got %d with toasts %v", http.StatusUnsupportedMediaType, res.StatusCode, fake.Toasts)
+	}
+
+	if code, res := request(t, "GET", ts.URL+ScriptPath, ""); code != http.StatusOK || !strings.Contains(res, "global.native = {") || !strings.Contains(res, "XMLHttpRequest") {
+		t.Errorf("unexpected script %d %s", code, res)
+	}
+}
+
+func TestCommander(t *testing.T) {
+	hub := sse.NewHub(sse.DefaultBuffer, time.Hour)
+	cmd := NewCommander(hub)
+	events, cancel := hub.Subscribe(CommandTopic, "")
+	defer cancel()
+
+	// without a WebView commands are published as events
+	cmd.Navigate("/items")
+	cmd.Reload()
+	cmd.EvaluateJavascript("alert(1)")
+	for _, want := range []sse.Event{
+		{ID: "1", Event: "navigate", Data: "/items"},
+		{ID: "2", Event: "reload"},
+		{ID: "3", Event: "eval", Data: "alert(1)"},
+	} {
+		select {
+		case ev := <-events:
+			if ev != want {
+				t.Errorf("want event %+v got %+v", want, ev)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for %+v", want)
+		}
+	}
+
+	view := &FakeWebView{}
+	cmd.Attach(view)
+	cmd.Navigate("/items")
+	cmd.Reload()
+	cmd.EvaluateJavascript("alert(1)")
+	if want := []string{"navigate /items", "reload", "eval alert(1)"}; !reflect.DeepEqual(view.Commands, want) {
+		t.Errorf("want commands %v got %v", want, view.Commands)
+	}
+	select {
+	case ev := <-events:
+		t.Errorf("unexpected event %+v with WebView attached", ev)
+	default:
+	}
+
+	cmd.Attach(nil)
+	cmd.Reload()
+	if ev := <-events; ev.Event != "reload" {
+		t.Errorf("want reload event after detaching got %+v", ev)
+	}
+}
+
+func TestShareReceiver(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shares")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	hub := sse.NewHub(sse.DefaultBuffer, time.Hour)
+	shares := NewShareReceiver(hub)
+	if _, err := (&FakeShareSource{Receiver: shares}).Send("", "too early"); err == nil {
+		t.Errorf("want an error receiving shares before SetDir")
+	}
+	if err := shares.SetDir(dir); err != nil {
+		t.Fatal(err)
+	}
+	events, cancel := hub.Subscribe(ShareTopic, "")
+	defer cancel()
+
+	src := &FakeShareSource{Receiver: shares}
+	id1, err := src.Send("", " https://golang.org/doc ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id2, err := src.Send("Photos", "From the trip",
+		FakeFile{Name: "../../beach.jpg", MimeType: "image/jpeg", Data: []byte("jpeg")},
+		FakeFile{Name: "beach.jpg", MimeType: "image/jpeg", Data: []byte("jpeg2")},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{id1, id2} {
+		select {
+		case ev := <-events:
+			var s Share
+			if err := json.Unmarshal([]byte(ev.Data), &s); err != nil || ev.Event != ShareTopic || s.ID != id {
+				t.Errorf("want shared event for %s got %+v %v", id, ev, err)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for shared event %s", id)
+		}
+	}
+
+	list, err := shares.Shares()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || list[0].URL != "https://golang.org/doc" || list[1].Subject != "Photos" {
+		t.Fatalf("unexpected shares %+v", list)
+	}
+	files := list[1].Files
+	if len(files) != 2 || files[0].Name != "beach.jpg" || files[1].Name != "1-beach.jpg" || files[1].Size != 5 {
+		t.Fatalf("unexpected files %+v", files)
+	}
+	if b, err := ioutil.ReadFile(files[1].Path); err != nil || string(b) != "jpeg2" {
+		t.Errorf("want stored file got %q %v", b, err)
+	}
+
+	router := contextrouter.New()
+	shares.Mount(router)
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+	defer router.Stop()
+
+	if code, res := request(t, "GET", ts.URL+SharesPath+"/"+id2+"/1-beach.jpg", ""); code != http.StatusOK || res != "jpeg2" {
+		t.Errorf("want shared file got %d %q", code, res)
+	}
+	if code, _ := request(t, "GET", ts.URL+SharesPath+"/"+id2+"/share.json", ""); code != http.StatusNotFound {
+		t.Errorf("want 404 for metadata got %d", code)
+	}
+	if code, _ := request(t, "DELETE", ts.URL+SharesPath+"/"+id1, ""); code != http.StatusNoContent {
+		t.Errorf("want 204 for delete got %d", code)
+	}
+	code, res := request(t, "GET", ts.URL+SharesPath, "")
+	if err := json.Unmarshal([]byte(res), &list); code != http.StatusOK || err != nil || len(list) != 1 || list[0].ID != id2 {
+		t.Errorf("want remaining share %s got %d %s", id2, code, res)
+	}
+
+	// aborted shares leave nothing behind
+	id3 := shares.Begin("", "")
+	if err := shares.AddFile(id3, files[0].Path, "copy.jpg", "image/jpeg"); err != nil {
+		t.Fatal(err)
+	}
+	shares.Abort(id3)
+	if _, err := os.Stat(filepath.Join(dir, id3)); !os.IsNotExist(err) {
+		t.Errorf("want aborted share removed got %v", err)
+	}
+	if err := shares.Commit(id3); err == nil {
+		t.Error("want error committing aborted share")
+	}
+	if err := shares.AddFile(id3, files[0].Path, "late.jpg", "image/jpeg"); err == nil {
+		t.Error("want error adding to aborted share")
+	}
+	if _, err := os.Stat(filepath.Join(dir, id3)); !os.IsNotExist(err) {
+		t.Errorf("want aborted share not recreated got %v", err)
+	}
+
+	// files of other types than inert media are downloaded rather than shown
+	id4, err := src.Send("", "", FakeFile{Name: "page.html", MimeType: "text/html", Data: []byte("<script>")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{id2 + "/beach.jpg": "image/jpeg", id4 + "/page.html": "application/octet-stream"} {
+		res, err := http.Get(ts.URL + SharesPath + "/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if ct := res.Header.Get("Content-Type"); ct != want || res.Header.Get("X-Content-Type-Options") != "nosniff" {
+			t.Errorf("%s: want Content-Type %s with nosniff got %v", name, want, res.Header)
+		}
+		if attachment := res.Header.Get("Content-Disposition") == "attachment"; attachment != (want == "application/octet-stream") {
+			t.Errorf("%s: unexpected Content-Disposition %q", name, res.Header.Get("Content-Disposition"))
+		}
+	}
+}
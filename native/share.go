@@ -0,0 +1,421 @@
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/srinathh/mobilehtml5app/contextrouter"
+	"github.com/srinathh/mobilehtml5app/sse"
+	"golang.org/x/net/context"
+)
+
+// ShareTopic is the sse topic and event type on which received shares are
+// published
+const ShareTopic = "shared"
+
+// Routes registered by ShareReceiver.Mount
+const (
+	SharedPath = Path + "/shared"
+	SharesPath = Path + "/shares"
+)
+
+// shareMeta is the name of the file holding the Share in its folder
+const shareMeta = "share.json"
+
+// Share is content shared into the app by another app
+type Share struct {
+	ID      string
+	Time    time.Time
+	Subject string `json:",omitempty"`
+	Text    string `json:",omitempty"`
+	// URL is set if Text is a URL, which is how Android shares links
+	URL   string       `json:",omitempty"`
+	Files []SharedFile `json:",omitempty"`
+}
+
+// SharedFile is a file received with a Share
+type SharedFile struct {
+	Name     string
+	MimeType string
+	Size     int64
+	// Path is the location of the file in the data folder
+	Path string `json:"-"`
+}
+
+// ShareReceiver receives content shared into the app by other apps, stores
+// it in a folder of the app's data folder and publishes each Share as an
+// event of type ShareTopic on the sse topic ShareTopic.
+//
+// The native portion of the app calls Begin with the text of a SEND intent,
+// AddFile or AddFD for each attached stream and finally Commit:
+//
+//	String id = shares.begin(subject, text);
+//	shares.addFD(id, pfd.detachFd(), name, mimeType);
+//	shares.commit(id);
+//
+// The Go backend can subscribe to the sse topic while the frontend can
+// listen to the event stream at SharedPath after calling Mount. Mount the
+// routes before the server starts and set the folder with SetDir once the
+// native portion of the app passes it.
+type ShareReceiver struct {
+	dir     string
+	hub     *sse.Hub
+	pending map[string]*Share
+	lastID  int64
+	sync.Mutex
+}
+
+// errNoDir is returned by a ShareReceiver before SetDir was called
+var errNoDir = errors.New("no share folder set")
+
+// NewShareReceiver returns a ShareReceiver publishing shares on hub. Shares
+// can't be stored until SetDir is called.
+func NewShareReceiver(hub *sse.Hub) *ShareReceiver {
+	return &ShareReceiver{
+		hub:     hub,
+		pending: make(map[string]*Share),
+	}
+}
+
+// SetDir sets the folder shares are stored in, which is created if it
+// doesn't exist.
+func (r *ShareReceiver) SetDir(dir string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("could not create share folder %s: %s", dir, err)
+	}
+	r.Lock()
+	r.dir = dir
+	r.Unlock()
+	return nil
+}
+
+// folder returns the folder of the share id
+func (r *ShareReceiver) folder(id string) (string, error) {
+	r.Lock()
+	defer r.Unlock()
+	if r.dir == "" {
+		return "", errNoDir
+	}
+	return filepath.Join(r.dir, id), nil
+}
+
+// Begin starts receiving a share with the given subject and text, either of
+// which may be empty, and returns its ID.
+func (r *ShareReceiver) Begin(subject, text string) string {
+	r.Lock()
+	defer r.Unlock()
+
+	now := time.Now()
+	id := now.UnixNano()
+	if id <= r.lastID {
+		id = r.lastID + 1
+	}
+	r.lastID = id
+
+	s := &Share{
+		ID:      strconv.FormatInt(id, 10),
+		Time:    now,
+		Subject: subject,
+		Text:    text,
+	}
+	if t := strings.TrimSpace(text); (strings.HasPrefix(t, "http://") || strings.HasPrefix(t, "https://")) && !strings.ContainsAny(t, " \n\t") {
+		s.URL = t
+	}
+	r.pending[s.ID] = s
+	return s.ID
+}
+
+// AddFile copies the file at path, typically a temporary copy made by the
+// native portion of the app, into the share id under name.
+func (r *ShareReceiver) AddFile(id, path, name, mimeType string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("could not open shared file: %s", err)
+	}
+	defer f.Close()
+	return r.add(id, f, name, mimeType)
+}
+
+// AddFD reads the open file descriptor fd into the share id under name. The
+// ShareReceiver takes ownership of fd and closes it.
+func (r *ShareReceiver) AddFD(id string, fd int, name, mimeType string) error {
+	f := os.NewFile(uintptr(fd), name)
+	if f == nil {
+		return fmt.Errorf("invalid file descriptor %d", fd)
+	}
+	defer f.Close()
+	return r.add(id, f, name, mimeType)
+}
+
+func (r *ShareReceiver) add(id string, src io.Reader, name, mimeType string) error {
+	// the folder is created under the lock along with the check that the
+	// share is pending so that add can't recreate it after Abort removed it
+	r.Lock()
+	s, ok := r.pending[id]
+	if !ok {
+		r.Unlock()
+		return fmt.Errorf("unknown share %s", id)
+	}
+	if r.dir == "" {
+		r.Unlock()
+		return errNoDir
+	}
+	folder := filepath.Join(r.dir, id)
+	if err := os.MkdirAll(folder, 0700); err != nil {
+		r.Unlock()
+		return fmt.Errorf("could not create share folder: %s", err)
+	}
+	name = uniqueName(s.Files, safeName(name))
+	fpath := filepath.Join(folder, name)
+	// reserve the name while copying
+	s.Files = append(s.Files, SharedFile{Name: name, MimeType: mimeType, Path: fpath})
+	idx := len(s.Files) - 1
+	r.Unlock()
+
+	dst, err := os.OpenFile(fpath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("could not create shared file: %s", err)
+	}
+	n, err := io.Copy(dst, src)
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(fpath)
+		return fmt.Errorf("could not store shared file: %s", err)
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	if r.pending[id] != s {
+		// aborted while copying: Abort removed the folder
+		return fmt.Errorf("share %s was aborted", id)
+	}
+	s.Files[idx].Size = n
+	return nil
+}
+
+// Commit stores the share id and publishes it.
+func (r *ShareReceiver) Commit(id string) error {
+	r.Lock()
+	s, ok := r.pending[id]
+	delete(r.pending, id)
+	r.Unlock()
+	if !ok {
+		return fmt.Errorf("unknown share %s", id)
+	}
+
+	folder, err := r.folder(id)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(folder, 0700); err != nil {
+		return fmt.Errorf("could not create share folder: %s", err)
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("could not encode share: %s", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(folder, shareMeta), b, 0600); err != nil {
+		return fmt.Errorf("could not store share: %s", err)
+	}
+	r.hub.Publish(ShareTopic, ShareTopic, string(b))
+	return nil
+}
+
+// Abort discards the uncommitted share id along with any files received.
+func (r *ShareReceiver) Abort(id string) {
+	r.Lock()
+	defer r.Unlock()
+	if _, ok := r.pending[id]; ok {
+		delete(r.pending, id)
+		if r.dir != "" {
+			os.RemoveAll(filepath.Join(r.dir, id))
+		}
+	}
+}
+
+// Shares returns the stored shares, oldest first. There are none before
+// SetDir is called.
+func (r *ShareReceiver) Shares() ([]Share, error) {
+	dir, err := r.folder("")
+	if err == errNoDir {
+		return nil, nil
+	}
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not list shares: %s", err)
+	}
+	var shares []Share
+	for _, fi := range infos {
+		if !fi.IsDir() {
+			continue
+		}
+		s, err := r.Get(fi.Name())
+		if err != nil {
+			// uncommitted or aborted while listing
+			continue
+		}
+		shares = append(shares, s)
+	}
+	sort.Sort(shareSorter(shares))
+	return shares, nil
+}
+
+type shareSorter []Share
+
+func (s shareSorter) Len() int           { return len(s) }
+func (s shareSorter) Less(i, j int) bool { return s[i].Time.Before(s[j].Time) }
+func (s shareSorter) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Get returns the stored share id.
+func (r *ShareReceiver) Get(id string) (Share, error) {
+	var s Share
+	if id != safeName(id) {
+		return s, fmt.Errorf("invalid share id %q", id)
+	}
+	folder, err := r.folder(id)
+	if err != nil {
+		return s, err
+	}
+	b, err := ioutil.ReadFile(filepath.Join(folder, shareMeta))
+	if err != nil {
+		return s, err
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return s, fmt.Errorf("could not decode share %s: %s", id, err)
+	}
+	for j := range s.Files {
+		s.Files[j].Path = filepath.Join(folder, s.Files[j].Name)
+	}
+	return s, nil
+}
+
+// Delete removes the stored share id and its files.
+func (r *ShareReceiver) Delete(id string) error {
+	if id != safeName(id) {
+		return fmt.Errorf("invalid share id %q", id)
+	}
+	folder, err := r.folder(id)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(folder)
+}
+
+// Mount registers routes for the frontend on router. The event stream of
+// shares is at SharedPath and stored shares are accessible under SharesPath:
+//
+//	GET    /_native/shared                  event stream of new shares
+//	GET    /_native/shares                  stored shares as JSON
+//	GET    /_native/shares/:shareid/*name   a file of a share, served as
+//	                                        an attachment unless it is an
+//	                                        image, audio, video, plain text
+//	                                        or PDF
+//	DELETE /_native/shares/:shareid         deletes a share
+func (r *ShareReceiver) Mount(router *contextrouter.ContextRouter) {
+	router.Handle(contextrouter.GET, SharedPath, r.hub.Topic(ShareTopic))
+
+	router.HandleFunc(contextrouter.GET, SharesPath, func(c context.Context, w http.ResponseWriter, req *http.Request) {
+		shares, err := r.Shares()
+		if err != nil {
+			log.Printf("native: %s", err)
+			http.Error(w, "", http.StatusInternalServerError)
+			return
+		}
+		if shares == nil {
+			shares = []Share{}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(shares)
+	})
+
+	router.HandleFunc(contextrouter.GET, SharesPath+"/:shareid/*name", func(c context.Context, w http.ResponseWriter, req *http.Request) {
+		s, err := r.Get(c.Value("shareid").(string))
+		if err != nil {
+			http.NotFound(w, req)
+			return
+		}
+		name := strings.TrimPrefix(c.Value("name").(string), "/")
+		for _, f := range s.Files {
+			if f.Name == name {
+				// the MIME type is chosen by the sending app so only inert
+				// types are shown inline lest shared HTML or SVG run scripts
+				// on the origin of the app
+				w.Header().Set("X-Content-Type-Options", "nosniff")
+				if inert(f.MimeType) {
+					w.Header().Set("Content-Type", f.MimeType)
+				} else {
+					w.Header().Set("Content-Type", "application/octet-stream")
+					w.Header().Set("Content-Disposition", "attachment")
+				}
+				http.ServeFile(w, req, f.Path)
+				return
+			}
+		}
+		http.NotFound(w, req)
+	})
+
+	router.HandleFunc(contextrouter.DELETE, SharesPath+"/:shareid", func(c context.Context, w http.ResponseWriter, req *http.Request) {
+		if err := r.Delete(c.Value("shareid").(string)); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// inert reports whether content of the MIME type mimeType can't run scripts
+// when shown by the WebView
+func inert(mimeType string) bool {
+	mimeType = strings.ToLower(strings.TrimSpace(strings.SplitN(mimeType, ";", 2)[0]))
+	switch {
+	case mimeType == "image/svg+xml":
+		return false
+	case strings.HasPrefix(mimeType, "image/"), strings.HasPrefix(mimeType, "audio/"), strings.HasPrefix(mimeType, "video/"):
+		return true
+	case mimeType == "text/plain", mimeType == "application/pdf":
+		return true
+	}
+	return false
+}
+
+// safeName returns name stripped of any folders so it can't escape the share
+// folder
+func safeName(name string) string {
+	name = filepath.Base(strings.Replace(name, "\\", "/", -1))
+	if name == "." || name == ".." || name == "/" || name == "" || name == shareMeta {
+		return "file"
+	}
+	return name
+}
+
+// uniqueName returns name or name prefixed with a number if it is already
+// taken by one of files
+func uniqueName(files []SharedFile, name string) string {
+	taken := func(n string) bool {
+		for _, f := range files {
+			if f.Name == n {
+				return true
+			}
+		}
+		return false
+	}
+	ret := name
+	for j := 1; taken(ret); j++ {
+		ret = fmt.Sprintf("%d-%s", j, name)
+	}
+	return ret
+}
@@ -0,0 +1,119 @@
+package native
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/srinathh/mobilehtml5app/contextrouter"
+	"github.com/srinathh/mobilehtml5app/sse"
+	"golang.org/x/net/context"
+)
+
+// WebView is implemented by the native portion of the app to let the Go
+// backend control the WebView, for instance to reload the page after a sync.
+// Methods are called from Go threads so implementations must post the work
+// to the UI thread.
+type WebView interface {
+	// Navigate loads rawurl in the WebView
+	Navigate(rawurl string)
+	// Reload reloads the current page
+	Reload()
+	// EvaluateJavascript runs snippet in the current page
+	EvaluateJavascript(snippet string)
+}
+
+// Routes registered by Commander.Mount and the sse topic commands are
+// published on when no WebView is attached
+const (
+	CommandPath       = Path + "/webview"
+	CommandScriptPath = Path + "/webview.js"
+	CommandTopic      = "_webview"
+)
+
+// Commander sends commands to the attached WebView. When none is attached,
+// for instance while previewing the app in a desktop browser, commands are
+// published as Server-Sent Events on CommandTopic instead and carried out by
+// a script served at CommandScriptPath:
+//
+//	srv.WebView.Mount(srv.Router)
+//	<script src="/_native/webview.js"></script>
+//
+// Commander implements WebView so code sending commands doesn't need to
+// know whether a native WebView is attached.
+type Commander struct {
+	hub  *sse.Hub
+	view WebView
+	sync.Mutex
+}
+
+// NewCommander returns a Commander publishing commands on hub while no
+// WebView is attached.
+func NewCommander(hub *sse.Hub) *Commander {
+	return &Commander{hub: hub}
+}
+
+// Attach attaches v to receive commands. Pass nil to detach the current
+// WebView and fall back to Server-Sent Events.
+func (c *Commander) Attach(v WebView) {
+	c.Lock()
+	c.view = v
+	c.Unlock()
+}
+
+// Navigate implements WebView
+func (c *Commander) Navigate(rawurl string) {
+	if v := c.attached(); v != nil {
+		v.Navigate(rawurl)
+		return
+	}
+	c.hub.Publish(CommandTopic, "navigate", rawurl)
+}
+
+// Reload implements WebView
+func (c *Commander) Reload() {
+	if v := c.attached(); v != nil {
+		v.Reload()
+		return
+	}
+	c.hub.Publish(CommandTopic, "reload", "")
+}
+
+// EvaluateJavascript implements WebView
+func (c *Commander) EvaluateJavascript(snippet string) {
+	if v := c.attached(); v != nil {
+		v.EvaluateJavascript(snippet)
+		return
+	}
+	c.hub.Publish(CommandTopic, "eval", snippet)
+}
+
+func (c *Commander) attached() WebView {
+	c.Lock()
+	defer c.Unlock()
+	return c.view
+}
+
+// Mount registers the event stream of commands at CommandPath and the script
+// carrying them out at CommandScriptPath on router.
+func (c *Commander) Mount(router *contextrouter.ContextRouter) {
+	router.Handle(contextrouter.GET, CommandPath, c.hub.Topic(CommandTopic))
+	router.HandleFunc(contextrouter.GET, CommandScriptPath, func(_ context.Context, w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/javascript")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Write([]byte(commandScript))
+	})
+}
+
+const commandScript = `(function(global) {
+    var events = new EventSource("` + CommandPath + `");
+    events.addEventListener("navigate", function(e) {
+        global.location.href = e.data;
+    });
+    events.addEventListener("reload", function() {
+        global.location.reload();
+    });
+    events.addEventListener("eval", function(e) {
+        (0, eval)(e.data);
+    });
+})(this);
+`
@@ -0,0 +1,223 @@
+// Package preview runs the App of a mobilehtml5app webapp on the desktop for
+// testing in a regular browser. It is used by the mobilehtml5app serve
+// command which generates a main package calling Run with the NewApp
+// function of the webapp package in the current folder.
+//
+// The App may optionally be exposed on a LAN address so that it can be
+// opened in the browser of a real phone. Since the App is not meant to be
+// reachable from other machines, LAN access requires a random token which is
+// printed along with a QR code of the URL for convenience.
+package preview
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"os/signal"
+	"reflect"
+	"time"
+
+	"rsc.io/qr"
+)
+
+// app is the interface the App returned by NewApp must satisfy
+type app interface {
+	Start() (string, error)
+	Stop()
+}
+
+// tokenCookie holds the LAN access token once a browser has presented it
+const tokenCookie = "mobilehtml5app_token"
+
+// Run creates the App by calling newApp, starts it and blocks until the
+// process is interrupted. newApp must be a NewApp function taking either no
+// parameters or the data folder as a string and returning the App and
+// optionally an error. Run parses its own command line flags:
+//
+//	-data string
+//		Optional. Data folder passed to NewApp. A temporary folder which is
+//		removed on exit is used if omitted.
+//	-lan string
+//		Optional. Address such as :8080 to expose the App on for other
+//		devices on the network. Requires a token printed on startup.
+//	-qr
+//		Optional. Print a QR code of the LAN URL. Requires -lan.
+func Run(newApp interface{}) error {
+	datadir := flag.String("data", "", "Optional. Data folder passed to NewApp. A temporary folder which is removed on exit is used if omitted.")
+	lan := flag.String("lan", "", "Optional. Address such as :8080 to expose the App on for other devices on the network.")
+	showQR := flag.Bool("qr", false, "Optional. Print a QR code of the LAN URL. Requires -lan.")
+	flag.Parse()
+
+	if *datadir == "" {
+		dir, err := ioutil.TempDir("", "mobilehtml5app")
+		if err != nil {
+			return fmt.Errorf("could not create temporary data folder: %s", err)
+		}
+		defer os.RemoveAll(dir)
+		*datadir = dir
+	}
+
+	a, err := callNewApp(newApp, *datadir)
+	if err != nil {
+		return err
+	}
+
+	appurl, err := a.Start()
+	if err != nil {
+		return fmt.Errorf("error starting app: %s", err)
+	}
+	defer a.Stop()
+	log.Printf("App URL is: %s/\nData Dir is: %s\n", appurl, *datadir)
+
+	if *lan != "" {
+		lanurl, err := serveLAN(*lan, appurl)
+		if err != nil {
+			return err
+		}
+		log.Printf("LAN URL is: %s\n", lanurl)
+		if *showQR {
+			if err := printQR(os.Stdout, lanurl); err != nil {
+				return err
+			}
+		}
+	}
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+	<-sig
+	return nil
+}
+
+// callNewApp calls newApp with the data folder if it takes a parameter and
+// returns the App along with any error returned by newApp
+func callNewApp(newApp interface{}, datadir string) (app, error) {
+	fn := reflect.ValueOf(newApp)
+	if fn.Kind() != reflect.Func {
+		return nil, fmt.Errorf("NewApp must be a function, got %T", newApp)
+	}
+
+	var args []reflect.Value
+	switch {
+	case fn.Type().NumIn() == 0:
+	case fn.Type().NumIn() == 1 && fn.Type().In(0).Kind() == reflect.String:
+		args = []reflect.Value{reflect.ValueOf(datadir)}
+	default:
+		return nil, fmt.Errorf("NewApp must take no parameters or the data folder as a string, got %T", newApp)
+	}
+
+	if n := fn.Type().NumOut(); n == 0 || n > 2 {
+		return nil, fmt.Errorf("NewApp must return the App and optionally an error, got %T", newApp)
+	}
+	out := fn.Call(args)
+	if len(out) == 2 {
+		if err, _ := out[1].Interface().(error); err != nil {
+			return nil, fmt.Errorf("error creating app: %s", err)
+		}
+	}
+	a, ok := out[0].Interface().(app)
+	if !ok {
+		return nil, fmt.Errorf("%s returned by NewApp does not have Start() (string, error) and Stop() methods", out[0].Type())
+	}
+	return a, nil
+}
+
+// serveLAN starts a reverse proxy to appurl on addr requiring a random
+// token and returns the URL including the token for other devices to use
+func serveLAN(addr, appurl string) (string, error) {
+	target, err := url.Parse(appurl)
+	if err != nil {
+		return "", fmt.Errorf("could not parse app url %s: %s", appurl, err)
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("could not generate token: %s", err)
+	}
+	token := hex.EncodeToString(b)
+
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", fmt.Errorf("could not listen on %s: %s", addr, err)
+	}
+
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	// flush regularly so that event streams reach the browser
+	proxy.FlushInterval = time.Millisecond * 100
+	go http.Serve(l, tokenHandler(token, proxy))
+
+	host, port, err := net.SplitHostPort(l.Addr().String())
+	if err != nil {
+		return "", err
+	}
+	if ip := net.ParseIP(host); ip == nil || ip.IsUnspecified() {
+		host = lanIP()
+	}
+	return fmt.Sprintf("http://%s/?token=%s", net.JoinHostPort(host, port), token), nil
+}
+
+// tokenHandler passes requests to h only if they carry token either as the
+// token query parameter or in a cookie set after the first such request
+func tokenHandler(token string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if c, err := r.Cookie(tokenCookie); err == nil && c.Value == token {
+			h.ServeHTTP(w, r)
+			return
+		}
+		if r.URL.Query().Get("token") != token {
+			http.Error(w, "a valid token is required", http.StatusForbidden)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: tokenCookie, Value: token, Path: "/", HttpOnly: true})
+		q := r.URL.Query()
+		q.Del("token")
+		r.URL.RawQuery = q.Encode()
+		http.Redirect(w, r, r.URL.String(), http.StatusFound)
+	})
+}
+
+// lanIP returns the first non loopback IPv4 address of the machine
+func lanIP() string {
+	addrs, err := net.InterfaceAddrs()
+	if err == nil {
+		for _, a := range addrs {
+			if ipnet, ok := a.(*net.IPNet); ok && !ipnet.IP.IsLoopback() && ipnet.IP.To4() != nil {
+				return ipnet.IP.String()
+			}
+		}
+	}
+	return "127.0.0.1"
+}
+
+// printQR writes a QR code of text to w using block characters with each
+// module of the code taking two columns so it looks square in a terminal.
+// Dark modules are left blank assuming a terminal with a dark background.
+func printQR(w io.Writer, text string) error {
+	code, err := qr.Encode(text, qr.M)
+	if err != nil {
+		return fmt.Errorf("could not encode QR code: %s", err)
+	}
+	const quiet = 2
+	for y := -quiet; y < code.Size+quiet; y++ {
+		line := ""
+		for x := -quiet; x < code.Size+quiet; x++ {
+			if code.Black(x, y) {
+				line += "  "
+			} else {
+				line += "██"
+			}
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
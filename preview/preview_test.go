@@ -0,0 +1,101 @@
+package preview
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type testApp struct {
+	datadir string
+}
+
+func (a *testApp) Start() (string, error) { return "http://127.0.0.1:1", nil }
+func (a *testApp) Stop()                  {}
+
+func TestCallNewApp(t *testing.T) {
+	tests := []struct {
+		newApp  interface{}
+		datadir string
+		fail    bool
+	}{
+		{func() *testApp { return &testApp{} }, "", false},
+		{func(d string) (*testApp, error) { return &testApp{datadir: d}, nil }, "/tmp/data", false},
+		{func(d string) (*testApp, error) { return nil, fmt.Errorf("no db") }, "", true},
+		{func(n int) *testApp { return &testApp{} }, "", true},
+		{func() string { return "" }, "", true},
+		{"NewApp", "", true},
+	}
+	for j, test := range tests {
+		a, err := callNewApp(test.newApp, "/tmp/data")
+		if test.fail {
+			if err == nil {
+				t.Errorf("%d: want error got none", j)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: %s", j, err)
+			continue
+		}
+		if got := a.(*testApp).datadir; got != test.datadir {
+			t.Errorf("%d: want data dir %q got %q", j, test.datadir, got)
+		}
+	}
+}
+
+func TestTokenHandler(t *testing.T) {
+	ts := httptest.NewServer(tokenHandler("secret", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "Hello, Phone")
+	})))
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusForbidden {
+		t.Errorf("without token: want %d got %d", http.StatusForbidden, res.StatusCode)
+	}
+
+	// a token in the query sets a cookie and redirects to the clean URL
+	req, _ := http.NewRequest("GET", ts.URL+"/items?token=secret", nil)
+	res, err = http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusFound || res.Header.Get("Location") != "/items" {
+		t.Errorf("with token: want redirect to /items got %d %s", res.StatusCode, res.Header.Get("Location"))
+	}
+	cookies := res.Cookies()
+	if len(cookies) != 1 || cookies[0].Value != "secret" {
+		t.Fatalf("with token: want token cookie got %v", cookies)
+	}
+
+	req, _ = http.NewRequest("GET", ts.URL+"/items", nil)
+	req.AddCookie(cookies[0])
+	res, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("with cookie: want %d got %d", http.StatusOK, res.StatusCode)
+	}
+}
+
+func TestPrintQR(t *testing.T) {
+	buf := bytes.Buffer{}
+	if err := printQR(&buf, "http://192.168.1.2:8080/?token=secret"); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) < 25 {
+		t.Errorf("QR code too small: %d lines", len(lines))
+	}
+}
@@ -0,0 +1,406 @@
+// Package rpc exposes plain Go functions to the frontend of a webapp using
+// JSON-RPC 2.0 so that neither the JSON endpoints nor the matching
+// Javascript calls need to be written by hand.
+//
+// Functions take the root Context and optionally a JSON decodable argument
+// and return a JSON encodable result and an error:
+//
+//	func(c context.Context, args Args) (Result, error)
+//	func(c context.Context) (Result, error)
+//
+// Register them on a Server and mount it on the router of the webapp:
+//
+//	rpcsrv := rpc.NewServer()
+//	rpcsrv.Register("items.create", app.createItem)
+//	rpcsrv.Mount(srv.Router)
+//
+// JSON-RPC requests are then served with HTTP POST at Path and optionally
+// over a WebSocket at WebSocketPath. A Javascript client is served at
+// ClientPath which defines a global rpc object with a function per method
+// returning a Promise of the result. Dotted method names are nested:
+//
+//	<script src="/_rpc/client.js"></script>
+//	rpc.items.create({Text: "Buy milk"}).then(function(item){ ... });
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/srinathh/mobilehtml5app/contextrouter"
+	"golang.org/x/net/context"
+)
+
+// Fixed routes at which Mount and MountWebSocket register the Server
+const (
+	Path          = "/_rpc"
+	ClientPath    = Path + "/client.js"
+	WebSocketPath = Path + "/ws"
+)
+
+// JSON-RPC 2.0 error codes
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+	// CodeServerError is used for errors returned by registered functions
+	// that are not an *Error
+	CodeServerError = -32000
+)
+
+// Error is a JSON-RPC error. Registered functions may return an *Error to
+// control the code and data sent to the client. Other errors are sent with
+// CodeServerError and the error text as message.
+type Error struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message)
+}
+
+// request and response are the JSON-RPC 2.0 envelopes
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// response.Result is encoded by invoke so that successful responses always
+// carry a result, null for a nil result, as JSON-RPC 2.0 requires
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// method is a registered function
+type method struct {
+	fn   reflect.Value
+	args reflect.Type // nil if the function takes no argument
+}
+
+// Server dispatches JSON-RPC requests to registered functions.
+type Server struct {
+	methods map[string]*method
+	sync.RWMutex
+}
+
+// NewServer returns a Server with no registered functions.
+func NewServer() *Server {
+	return &Server{
+		methods: make(map[string]*method),
+	}
+}
+
+// Register registers fn to be called for the method name. It returns an
+// error if fn does not have one of the supported signatures.
+func (s *Server) Register(name string, fn interface{}) error {
+	v := reflect.ValueOf(fn)
+	t := v.Type()
+	if t.Kind() != reflect.Func {
+		return fmt.Errorf("rpc: %s must be a function, got %s", name, t)
+	}
+	if t.NumIn() < 1 || t.NumIn() > 2 || t.In(0) != contextType {
+		return fmt.Errorf("rpc: %s must take a context.Context and optionally an argument, got %s", name, t)
+	}
+	if t.NumOut() != 2 || t.Out(1) != errorType {
+		return fmt.Errorf("rpc: %s must return a result and an error, got %s", name, t)
+	}
+
+	m := &method{fn: v}
+	if t.NumIn() == 2 {
+		m.args = t.In(1)
+	}
+	s.Lock()
+	s.methods[name] = m
+	s.Unlock()
+	return nil
+}
+
+// Methods returns the names of the registered functions in sorted order.
+func (s *Server) Methods() []string {
+	s.RLock()
+	defer s.RUnlock()
+	names := make([]string, 0, len(s.methods))
+	for name := range s.methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Mount registers the Server for HTTP POST requests at Path and the
+// Javascript client at ClientPath on router.
+func (s *Server) Mount(router *contextrouter.ContextRouter) {
+	router.Handle(contextrouter.POST, Path, s)
+	router.HandleFunc(contextrouter.GET, ClientPath, s.serveClient)
+}
+
+// MountWebSocket registers the Server for JSON-RPC requests sent as
+// messages over a WebSocket at WebSocketPath on router. Responses are sent
+// back as messages in the order the requests are received.
+func (s *Server) MountWebSocket(router *contextrouter.ContextRouter) {
+	router.HandleWebSocket(WebSocketPath, func(c context.Context, conn *contextrouter.Conn) {
+		for {
+			msg, err := conn.Receive()
+			if err != nil {
+				return
+			}
+			if res := s.handle(c, msg); res != nil {
+				if err := conn.Send(string(res)); err != nil {
+					return
+				}
+			}
+		}
+	})
+}
+
+// ServeHTTP serves a JSON-RPC request or batch sent with HTTP POST.
+func (s *Server) ServeHTTP(c context.Context, w http.ResponseWriter, r *http.Request) {
+	buf := bytes.Buffer{}
+	if _, err := buf.ReadFrom(r.Body); err != nil {
+		http.Error(w, "", http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	res := s.handle(c, buf.Bytes())
+	if res == nil {
+		// only notifications were sent
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Write(res)
+}
+
+// handle processes a request or a batch of requests and returns the encoded
+// response or nil if there is nothing to respond with.
+func (s *Server) handle(c context.Context, msg []byte) []byte {
+	msg = bytes.TrimSpace(msg)
+
+	var ret interface{}
+	if len(msg) > 0 && msg[0] == '[' {
+		var batch []json.RawMessage
+		if err := json.Unmarshal(msg, &batch); err != nil {
+			ret = errResponse(nil, CodeParseError, err.Error())
+		} else if len(batch) == 0 {
+			ret = errResponse(nil, CodeInvalidRequest, "empty batch")
+		} else {
+			var responses []*response
+			for _, m := range batch {
+				if res := s.call(c, m); res != nil {
+					responses = append(responses, res)
+				}
+			}
+			if len(responses) == 0 {
+				return nil
+			}
+			ret = responses
+		}
+	} else {
+		res := s.call(c, msg)
+		if res == nil {
+			return nil
+		}
+		ret = res
+	}
+
+	b, err := json.Marshal(ret)
+	if err != nil {
+		log.Printf("rpc: could not encode response: %s", err)
+		b, _ = json.Marshal(errResponse(nil, CodeInternalError, "could not encode response"))
+	}
+	return b
+}
+
+// call processes a single request and returns the response or nil for a
+// notification.
+func (s *Server) call(c context.Context, msg json.RawMessage) *response {
+	var req request
+	if err := json.Unmarshal(msg, &req); err != nil {
+		return errResponse(nil, CodeParseError, err.Error())
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return errResponse(req.ID, CodeInvalidRequest, "not a JSON-RPC 2.0 request")
+	}
+
+	res := s.invoke(c, req)
+	if req.ID == nil {
+		return nil
+	}
+	res.ID = req.ID
+	return res
+}
+
+// invoke calls the registered function for req
+func (s *Server) invoke(c context.Context, req request) *response {
+	s.RLock()
+	m, ok := s.methods[req.Method]
+	s.RUnlock()
+	if !ok {
+		return errResponse(nil, CodeMethodNotFound, "method not found: "+req.Method)
+	}
+
+	in := []reflect.Value{reflect.ValueOf(c)}
+	if m.args != nil {
+		args, err := decodeParams(req.Params, m.args)
+		if err != nil {
+			return errResponse(nil, CodeInvalidParams, err.Error())
+		}
+		in = append(in, args)
+	}
+
+	out := m.fn.Call(in)
+	if err, _ := out[1].Interface().(error); err != nil {
+		if rpcerr, ok := err.(*Error); ok {
+			return &response{JSONRPC: "2.0", Error: rpcerr}
+		}
+		return errResponse(nil, CodeServerError, err.Error())
+	}
+	result, err := json.Marshal(out[0].Interface())
+	if err != nil {
+		log.Printf("rpc: could not encode result of %s: %s", req.Method, err)
+		return errResponse(nil, CodeInternalError, "could not encode result")
+	}
+	return &response{JSONRPC: "2.0", Result: result}
+}
+
+// decodeParams decodes params given either by name as an object or by
+// position as an array with a single element into a value of type t
+func decodeParams(params json.RawMessage, t reflect.Type) (reflect.Value, error) {
+	v := reflect.New(t)
+	params = bytes.TrimSpace(params)
+	if len(params) == 0 || bytes.Equal(params, []byte("null")) {
+		return v.Elem(), nil
+	}
+	if params[0] == '[' {
+		var positional []json.RawMessage
+		if err := json.Unmarshal(params, &positional); err != nil {
+			return v, err
+		}
+		if len(positional) != 1 {
+			return v, fmt.Errorf("want 1 positional parameter, got %d", len(positional))
+		}
+		params = positional[0]
+	}
+	if err := json.Unmarshal(params, v.Interface()); err != nil {
+		return v, err
+	}
+	return v.Elem(), nil
+}
+
+func errResponse(id json.RawMessage, code int, message string) *response {
+	return &response{JSONRPC: "2.0", Error: &Error{Code: code, Message: message}, ID: id}
+}
+
+// serveClient serves the generated Javascript client
+func (s *Server) serveClient(_ context.Context, w http.ResponseWriter, r *http.Request) {
+	buf := bytes.Buffer{}
+	if err := clientTmpl.Execute(&buf, s.clientParams()); err != nil {
+		log.Printf("rpc: could not generate client: %s", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/javascript")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Write(buf.Bytes())
+}
+
+// clientMethod describes a registered function for the client template
+type clientMethod struct {
+	Name      string // full method name
+	Namespace []string
+	Func      string // last component of the name
+}
+
+func (s *Server) clientParams() interface{} {
+	var methods []clientMethod
+	for _, name := range s.Methods() {
+		parts := strings.Split(name, ".")
+		methods = append(methods, clientMethod{
+			Name:      name,
+			Namespace: parts[:len(parts)-1],
+			Func:      parts[len(parts)-1],
+		})
+	}
+	return struct {
+		Path    string
+		Methods []clientMethod
+	}{Path, methods}
+}
+
+var clientTmpl = template.Must(template.New("client").Funcs(template.FuncMap{
+	"js": func(s string) string {
+		b, _ := json.Marshal(s)
+		return string(b)
+	},
+}).Parse(`// Code generated by the mobilehtml5app rpc package. DO NOT EDIT.
+(function(global) {
+    var nextID = 1;
+
+    // XMLHttpRequest is used rather than fetch which older Android
+    // WebViews lack
+    function call(method, params) {
+        return new Promise(function(resolve, reject) {
+            var xhr = new XMLHttpRequest();
+            xhr.open("POST", {{js .Path}});
+            xhr.setRequestHeader("Content-Type", "application/json");
+            xhr.onload = function() {
+                var res;
+                try {
+                    res = JSON.parse(xhr.responseText);
+                } catch (e) {
+                    reject(new Error("rpc: invalid response with status " + xhr.status));
+                    return;
+                }
+                if (res.error) {
+                    var err = new Error(res.error.message);
+                    err.code = res.error.code;
+                    err.data = res.error.data;
+                    reject(err);
+                    return;
+                }
+                resolve(res.result);
+            };
+            xhr.onerror = function() {
+                reject(new Error("rpc: request failed"));
+            };
+            xhr.send(JSON.stringify({jsonrpc: "2.0", method: method, params: params, id: nextID++}));
+        });
+    }
+
+    function namespace(obj, names) {
+        for (var i = 0; i < names.length; i++) {
+            obj = obj[names[i]] = obj[names[i]] || {};
+        }
+        return obj;
+    }
+
+    var rpc = {call: call};
+{{range .Methods}}
+    namespace(rpc, [{{range $i, $n := .Namespace}}{{if $i}}, {{end}}{{js $n}}{{end}}])[{{js .Func}}] = function(params) {
+        return call({{js .Name}}, params);
+    };
+{{end}}
+    global.rpc = rpc;
+})(this);
+`))
@@ -0,0 +1,197 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/srinathh/mobilehtml5app/contextrouter"
+	"golang.org/x/net/context"
+	"golang.org/x/net/websocket"
+)
+
+type addArgs struct {
+	A, B int
+}
+
+func newTestServer(t *testing.T) (*contextrouter.ContextRouter, *httptest.Server) {
+	s := NewServer()
+	regs := map[string]interface{}{
+		"math.add": func(c context.Context, args addArgs) (int, error) {
+			return args.A + args.B, nil
+		},
+		"ping": func(c context.Context) (string, error) {
+			return "pong", nil
+		},
+		"nothing": func(c context.Context) (interface{}, error) {
+			return nil, nil
+		},
+		"fail": func(c context.Context) (string, error) {
+			return "", errors.New("failed")
+		},
+		"teapot": func(c context.Context) (string, error) {
+			return "", &Error{Code: 418, Message: "teapot", Data: "short and stout"}
+		},
+	}
+	for name, fn := range regs {
+		if err := s.Register(name, fn); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	router := contextrouter.New()
+	s.Mount(router)
+	s.MountWebSocket(router)
+	return router, httptest.NewServer(router)
+}
+
+func post(t *testing.T, url, body string) (int, string) {
+	res, err := http.Post(url+Path, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.StatusCode, string(b)
+}
+
+func TestRegister(t *testing.T) {
+	s := NewServer()
+	bad := []interface{}{
+		"notafunc",
+		func() (int, error) { return 0, nil },
+		func(c context.Context, a, b int) (int, error) { return 0, nil },
+		func(s string) (int, error) { return 0, nil },
+		func(c context.Context) int { return 0 },
+		func(c context.Context) (int, string) { return 0, "" },
+	}
+	for _, fn := range bad {
+		if err := s.Register("bad", fn); err == nil {
+			t.Errorf("want error registering %T", fn)
+		}
+	}
+	if len(s.Methods()) != 0 {
+		t.Errorf("want no methods got %v", s.Methods())
+	}
+}
+
+func TestHTTP(t *testing.T) {
+	router, ts := newTestServer(t)
+	defer ts.Close()
+	defer router.Stop()
+
+	tests := []struct {
+		req  string
+		want string
+	}{
+		{`{"jsonrpc":"2.0","method":"math.add","params":{"A":1,"B":2},"id":1}`,
+			`{"jsonrpc":"2.0","result":3,"id":1}`},
+		{`{"jsonrpc":"2.0","method":"math.add","params":[{"A":3,"B":4}],"id":"x"}`,
+			`{"jsonrpc":"2.0","result":7,"id":"x"}`},
+		{`{"jsonrpc":"2.0","method":"ping","id":2}`,
+			`{"jsonrpc":"2.0","result":"pong","id":2}`},
+		{`{"jsonrpc":"2.0","method":"nothing","id":8}`,
+			`{"jsonrpc":"2.0","result":null,"id":8}`},
+		{`{"jsonrpc":"2.0","method":"fail","id":3}`,
+			`{"jsonrpc":"2.0","error":{"code":-32000,"message":"failed"},"id":3}`},
+		{`{"jsonrpc":"2.0","method":"teapot","id":4}`,
+			`{"jsonrpc":"2.0","error":{"code":418,"message":"teapot","data":"short and stout"},"id":4}`},
+		{`{"jsonrpc":"2.0","method":"missing","id":5}`,
+			`{"jsonrpc":"2.0","error":{"code":-32601,"message":"method not found: missing"},"id":5}`},
+		{`{"jsonrpc":"1.0","method":"ping","id":6}`,
+			`{"jsonrpc":"2.0","error":{"code":-32600,"message":"not a JSON-RPC 2.0 request"},"id":6}`},
+		{`[{"jsonrpc":"2.0","method":"ping","id":1},{"jsonrpc":"2.0","method":"ping"},{"jsonrpc":"2.0","method":"math.add","params":{"A":1,"B":1},"id":2}]`,
+			`[{"jsonrpc":"2.0","result":"pong","id":1},{"jsonrpc":"2.0","result":2,"id":2}]`},
+	}
+	for _, test := range tests {
+		if code, got := post(t, ts.URL, test.req); code != http.StatusOK || got != test.want {
+			t.Errorf("for %s want %s got %d %s", test.req, test.want, code, got)
+		}
+	}
+
+	// errors without a usable id
+	for _, req := range []string{`{"jsonrpc":`, `[]`} {
+		var res response
+		_, got := post(t, ts.URL, req)
+		if err := json.Unmarshal([]byte(got), &res); err != nil || res.Error == nil || string(res.ID) != "null" {
+			t.Errorf("for %s want error response with null id got %s", req, got)
+		}
+	}
+
+	_, got := post(t, ts.URL, `{"jsonrpc":"2.0","method":"math.add","params":{"A":"one"},"id":7}`)
+	if !strings.Contains(got, `"code":-32602`) {
+		t.Errorf("want invalid params error got %s", got)
+	}
+
+	// notifications get no response
+	if code, got := post(t, ts.URL, `{"jsonrpc":"2.0","method":"ping"}`); code != http.StatusNoContent || got != "" {
+		t.Errorf("want 204 with no body for notification got %d %s", code, got)
+	}
+}
+
+func TestWebSocket(t *testing.T) {
+	router, ts := newTestServer(t)
+	defer ts.Close()
+	defer router.Stop()
+
+	ws, err := websocket.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+WebSocketPath, "", ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ws.Close()
+
+	for _, req := range []string{
+		`{"jsonrpc":"2.0","method":"ping"}`,
+		`{"jsonrpc":"2.0","method":"math.add","params":{"A":20,"B":22},"id":1}`,
+	} {
+		if err := websocket.Message.Send(ws, req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var got string
+	if err := websocket.Message.Receive(ws, &got); err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"jsonrpc":"2.0","result":42,"id":1}`; got != want {
+		t.Errorf("want %s got %s", want, got)
+	}
+}
+
+func TestClient(t *testing.T) {
+	router, ts := newTestServer(t)
+	defer ts.Close()
+	defer router.Stop()
+
+	res, err := http.Get(ts.URL + ClientPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ct := res.Header.Get("Content-Type"); ct != "application/javascript" {
+		t.Errorf("want Content-Type application/javascript got %s", ct)
+	}
+
+	js := string(b)
+	for _, want := range []string{
+		`xhr.open("POST", "/_rpc");`,
+		`namespace(rpc, ["math"])["add"] = function(params) {`,
+		`return call("math.add", params);`,
+		`namespace(rpc, [])["ping"] = function(params) {`,
+		`global.rpc = rpc;`,
+	} {
+		if !strings.Contains(js, want) {
+			t.Errorf("client does not contain %s:\n%s", want, js)
+		}
+	}
+}
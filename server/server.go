@@ -17,6 +17,26 @@
 // or computation should check for Done channel closure and abandon or finish
 // work if closed. See https://blog.golang.org/context for an illustration. Server
 // uses github.com/tylerb/graceful package for the shutdown functionality.
+//
+// Server.Events is a hub for pushing Server-Sent Events to the frontend. Event
+// streams end when the root Context is closed on Stop() so they don't hold
+// up the shutdown. See the sse package for details. Similarly, WebSocket
+// handlers registered with Router.HandleWebSocket() have their connections
+// closed with a proper close frame on Stop().
+//
+// Server.WebView sends navigate, reload and script commands to the WebView.
+// The native portion of the app attaches its WebView to receive them and
+// they are otherwise sent as events on Server.Events. See the native package.
+//
+// Apps kept alive by a foreground service call Server.Detach() rather than
+// Stop() when the UI goes to the background so that uploads or computations
+// started by the user continue. Handlers can check Server.Detached() to
+// avoid work that only matters while the UI is visible.
+//
+// Background work that must run while the UI is closed can be registered with
+// Server.RegisterTask() and run with Server.RunTask() without starting the
+// server. Tasks share the root Context with the handlers and are therefore
+// also signalled to finish on Stop().
 package server
 
 import (
@@ -27,6 +47,8 @@ import (
 	"time"
 
 	"github.com/srinathh/mobilehtml5app/contextrouter"
+	"github.com/srinathh/mobilehtml5app/native"
+	"github.com/srinathh/mobilehtml5app/sse"
 	"github.com/tylerb/graceful"
 )
 
@@ -39,17 +61,24 @@ const verifyDelay = time.Millisecond * 30
 // Server is an integrated http server with graceful shutdown and parameterized
 // routing capabilities
 type Server struct {
-	Router *contextrouter.ContextRouter
-	server *graceful.Server
+	Router   *contextrouter.ContextRouter
+	Events   *sse.Hub
+	WebView  *native.Commander
+	server   *graceful.Server
+	tasks    map[string]Task
+	detached bool
 	sync.RWMutex
 }
 
 // NewServer initializes and returns a new Server. Call Start() to start the
 // server and Stop() to shut it down.
 func NewServer() *Server {
+	events := sse.NewHub(sse.DefaultBuffer, sse.DefaultHeartbeat)
 	return &Server{
-		Router: contextrouter.New(),
-		server: nil,
+		Router:  contextrouter.New(),
+		Events:  events,
+		WebView: native.NewCommander(events),
+		server:  nil,
 	}
 }
 
@@ -94,11 +123,13 @@ func (s *Server) Start(addr string) (string, error) {
 
 // Stop closes the done channel of the root Context of the server to signal
 // any open handlers to terminate and shuts down the server after
-// waiting for upto the TimeOut period for any handlers to close. Stop blocks
-// until the server closes
+// waiting for upto the TimeOut period for any handlers to close. Open
+// WebSocket connections are given the same period to send their close frame.
+// Stop blocks until the server closes
 func (s *Server) Stop(timeOut time.Duration) {
+	// tasks may be running without the server
+	s.Router.StopTimeout(timeOut)
 	if s.server != nil {
-		s.Router.Stop()
 		s.server.Stop(timeOut)
 		select {
 		case <-s.server.StopChan():
@@ -106,3 +137,27 @@ func (s *Server) Stop(timeOut time.Duration) {
 		s.server = nil
 	}
 }
+
+// Detach marks the server as detached from the UI while it keeps serving
+// requests, for instance when the app is kept alive by a foreground service
+// while the WebView is in the background. Unlike Stop, handlers are not
+// signalled to finish.
+func (s *Server) Detach() {
+	s.Lock()
+	s.detached = true
+	s.Unlock()
+}
+
+// Attach marks the server as attached to the UI again after Detach.
+func (s *Server) Attach() {
+	s.Lock()
+	s.detached = false
+	s.Unlock()
+}
+
+// Detached reports whether the server is detached from the UI.
+func (s *Server) Detached() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.detached
+}
@@ -1,15 +1,19 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/srinathh/mobilehtml5app/contextrouter"
 
 	"golang.org/x/net/context"
+	"golang.org/x/net/websocket"
 )
 
 func initServer() *Server {
@@ -98,3 +102,205 @@ func TestUnStoppedStart(t *testing.T) {
 	}
 	srv.Stop(time.Millisecond * 100)
 }
+
+func TestEventsStop(t *testing.T) {
+	srv := NewServer()
+	srv.Router.Handle(contextrouter.GET, "/events/:topic", srv.Events)
+
+	rooturl, err := srv.Start("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.Get(rooturl + "/events/items")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	stopped := make(chan struct{})
+	go func() {
+		srv.Stop(time.Millisecond * 100)
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("server did not stop with an open event stream")
+	}
+	if _, err := ioutil.ReadAll(res.Body); err != nil {
+		t.Errorf("event stream not closed cleanly: %s", err)
+	}
+}
+
+func TestWebSocketStop(t *testing.T) {
+	srv := NewServer()
+	srv.Router.HandleWebSocket("/echo", func(c context.Context, conn *contextrouter.Conn) {
+		for {
+			msg, err := conn.Receive()
+			if err != nil {
+				return
+			}
+			if err := conn.Send(string(msg)); err != nil {
+				return
+			}
+		}
+	})
+
+	rooturl, err := srv.Start("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var conns []*websocket.Conn
+	for j := 0; j < 3; j++ {
+		ws, err := websocket.Dial("ws"+strings.TrimPrefix(rooturl, "http")+"/echo", "", rooturl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ws.Close()
+		if err := websocket.Message.Send(ws, "Namaste"); err != nil {
+			t.Fatal(err)
+		}
+		var got string
+		if err := websocket.Message.Receive(ws, &got); err != nil || got != "Namaste" {
+			t.Fatalf("want echo Namaste got %q %v", got, err)
+		}
+		conns = append(conns, ws)
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		srv.Stop(time.Millisecond * 100)
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("server did not stop with open websockets")
+	}
+
+	// the client sees a close frame which x/net/websocket reports as io.EOF
+	// rather than a connection reset
+	for _, ws := range conns {
+		var got string
+		if err := websocket.Message.Receive(ws, &got); err != io.EOF {
+			t.Errorf("want io.EOF from close frame got %v", err)
+		}
+	}
+}
+
+func TestRunTask(t *testing.T) {
+	srv := NewServer()
+	srv.RegisterTask("sum", func(c context.Context, args json.RawMessage) error {
+		var nums []int
+		if err := json.Unmarshal(args, &nums); err != nil {
+			return err
+		}
+		if len(nums) != 2 || nums[0]+nums[1] != 3 {
+			return fmt.Errorf("unexpected args %s", args)
+		}
+		return nil
+	})
+	srv.RegisterTask("wait", func(c context.Context, args json.RawMessage) error {
+		<-c.Done()
+		return c.Err()
+	})
+	srv.RegisterTask("panic", func(c context.Context, args json.RawMessage) error {
+		panic("oops")
+	})
+
+	deadline := time.Now().Add(time.Second)
+	if err := srv.RunTask("sum", "[1, 2]", deadline); err != nil {
+		t.Error(err)
+	}
+	if err := srv.RunTask("sum", "[1,", deadline); err == nil {
+		t.Error("want error for invalid arguments")
+	}
+	if err := srv.RunTask("missing", "", deadline); err == nil {
+		t.Error("want error for unregistered task")
+	}
+	if err := srv.RunTask("panic", "", deadline); err == nil || !strings.Contains(err.Error(), "oops") {
+		t.Errorf("want panic reported as error got %v", err)
+	}
+	if err := srv.RunTask("wait", "", time.Now().Add(time.Millisecond*50)); err != context.DeadlineExceeded {
+		t.Errorf("want deadline exceeded got %v", err)
+	}
+
+	// Stop signals running tasks and waits for them without a started server
+	done := make(chan error)
+	go func() {
+		done <- srv.RunTask("wait", "", time.Now().Add(time.Hour))
+	}()
+	time.Sleep(time.Millisecond * 50)
+	srv.Stop(time.Millisecond * 100)
+	select {
+	case err := <-done:
+		if err != context.Canceled {
+			t.Errorf("want canceled got %v", err)
+		}
+	default:
+		t.Error("Stop returned before the task")
+	}
+}
+
+func TestRunTaskFromHandler(t *testing.T) {
+	srv := NewServer()
+	srv.RegisterTask("wait", func(c context.Context, args json.RawMessage) error {
+		<-c.Done()
+		return c.Err()
+	})
+	entered := make(chan bool)
+	result := make(chan error, 1)
+	srv.Router.HandleFunc(contextrouter.GET, "/task", func(c context.Context, w http.ResponseWriter, r *http.Request) {
+		entered <- true
+		<-c.Done()
+		// Stop is now waiting for this handler to return
+		time.Sleep(time.Millisecond * 50)
+		result <- srv.RunTask("wait", "", time.Now().Add(time.Hour))
+	})
+	root, err := srv.Start("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go http.Get(root + "/task")
+	<-entered
+
+	stopped := make(chan bool)
+	go func() {
+		srv.Stop(time.Millisecond * 100)
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second * 5):
+		t.Fatal("Stop deadlocked with a handler running a task")
+	}
+	if err := <-result; err != context.Canceled {
+		t.Errorf("want canceled got %v", err)
+	}
+}
+
+func TestDetach(t *testing.T) {
+	srv := initServer()
+	url, err := srv.Start("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Stop(time.Millisecond * 100)
+
+	if srv.Detached() {
+		t.Error("want attached after start")
+	}
+	srv.Detach()
+	if !srv.Detached() {
+		t.Error("want detached after Detach")
+	}
+	// handlers keep running while detached
+	if err := checkResponse(url+"/Hello/Alice", "Hello, Alice"); err != nil {
+		t.Error(err)
+	}
+	srv.Attach()
+	if srv.Detached() {
+		t.Error("want attached after Attach")
+	}
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// Task is background work such as syncing or compacting a database that can
+// run while the UI of the app is closed. args holds the JSON arguments passed
+// to RunTask. Tasks must return when the Done channel of the Context is
+// closed, either on reaching the deadline or on Stop().
+type Task func(c context.Context, args json.RawMessage) error
+
+// RegisterTask registers task under name for RunTask.
+func (s *Server) RegisterTask(name string, task Task) {
+	s.Lock()
+	defer s.Unlock()
+	if s.tasks == nil {
+		s.tasks = make(map[string]Task)
+	}
+	s.tasks[name] = task
+}
+
+// RunTask runs the task registered under name with the JSON arguments in
+// argsJSON until it returns. The task gets the root Context of the Router
+// with the given deadline so it shares the lifecycle of the handlers but the
+// server doesn't need to be started. This makes RunTask suitable for calls
+// from the native portion of the app such as a WorkManager worker. It may also
+// be called from handlers. A task panicking is reported as an error.
+func (s *Server) RunTask(name, argsJSON string, deadline time.Time) (err error) {
+	s.RLock()
+	task, ok := s.tasks[name]
+	s.RUnlock()
+	if !ok {
+		return fmt.Errorf("no task registered as %s", name)
+	}
+
+	args := json.RawMessage("null")
+	if argsJSON != "" {
+		if !json.Valid([]byte(argsJSON)) {
+			return fmt.Errorf("arguments for task %s are not valid JSON", name)
+		}
+		args = json.RawMessage(argsJSON)
+	}
+
+	s.Router.Run(func(root context.Context) {
+		c, cancel := context.WithDeadline(root, deadline)
+		defer cancel()
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("task %s panicked: %v", name, r)
+			}
+		}()
+		err = task(c, args)
+	})
+	return err
+}
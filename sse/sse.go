@@ -0,0 +1,234 @@
+// Package sse provides a hub for pushing Server-Sent Events from the Go
+// backend to the frontend of a webapp.
+//
+// Events are published to named topics. Each topic keeps a bounded buffer of
+// recent events so that clients reconnecting with a Last-Event-ID header,
+// which EventSource does automatically, receive the events they missed.
+// Topics are only kept once events are published to them, so clients
+// subscribing to arbitrary topic names don't grow the memory of the Hub.
+// Streams send periodic heartbeats so that idle connections are not dropped
+// and end cleanly when the root context of the server is closed on
+// Server.Stop(), which mobile apps typically call when they are paused.
+//
+// A Hub is available as Server.Events. Register it on a route with a topic
+// parameter and publish from anywhere in the backend:
+//
+//	srv.Router.Handle(contextrouter.GET, "/events/:topic", srv.Events)
+//	srv.Events.Publish("items", "changed", "")
+//
+// and listen in the frontend:
+//
+//	new EventSource("/events/items").addEventListener("changed", reload);
+package sse
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/srinathh/mobilehtml5app/contextrouter"
+	"golang.org/x/net/context"
+)
+
+// Defaults used by Server.Events
+const (
+	DefaultBuffer    = 64
+	DefaultHeartbeat = time.Second * 15
+)
+
+// subscriberQueue is the number of events queued for a subscriber. Slow
+// subscribers whose queue is full are disconnected and are expected to
+// reconnect and catch up using Last-Event-ID.
+const subscriberQueue = 16
+
+// Event is a single Server-Sent Event. ID is assigned by the Hub on Publish
+// and increases by one for each event in a topic.
+type Event struct {
+	ID    string
+	Event string
+	Data  string
+}
+
+// Hub distributes events published to named topics to subscribers.
+type Hub struct {
+	buffer    int
+	heartbeat time.Duration
+	topics    map[string]*topic
+	sync.Mutex
+}
+
+type topic struct {
+	seq  uint64
+	buf  []Event
+	subs map[chan Event]bool
+}
+
+// NewHub returns a Hub keeping the last buffer events of each topic for
+// replay and sending heartbeats on idle streams at the given interval. No
+// heartbeats are sent if heartbeat is 0 or less.
+func NewHub(buffer int, heartbeat time.Duration) *Hub {
+	return &Hub{
+		buffer:    buffer,
+		heartbeat: heartbeat,
+		topics:    make(map[string]*topic),
+	}
+}
+
+func (h *Hub) topic(name string) *topic {
+	t, ok := h.topics[name]
+	if !ok {
+		t = &topic{subs: make(map[chan Event]bool)}
+		h.topics[name] = t
+	}
+	return t
+}
+
+// Publish sends an event of the given type with data to all subscribers of
+// the topic and returns its ID. An empty event type results in a plain
+// message event.
+func (h *Hub) Publish(name, event, data string) string {
+	h.Lock()
+	defer h.Unlock()
+
+	t := h.topic(name)
+	t.seq++
+	ev := Event{ID: strconv.FormatUint(t.seq, 10), Event: event, Data: data}
+	t.buf = append(t.buf, ev)
+	if len(t.buf) > h.buffer {
+		t.buf = t.buf[len(t.buf)-h.buffer:]
+	}
+
+	for ch := range t.subs {
+		select {
+		case ch <- ev:
+		default:
+			delete(t.subs, ch)
+			close(ch)
+		}
+	}
+	return ev.ID
+}
+
+// PublishJSON is like Publish but sends v encoded as JSON as the data.
+func (h *Hub) PublishJSON(name, event string, v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("could not encode event data: %s", err)
+	}
+	return h.Publish(name, event, string(b)), nil
+}
+
+// Subscribe returns a channel receiving the events published to the topic
+// after the event with ID lastID, starting with any such events still in the
+// buffer. Pass an empty lastID for new events only. The channel is closed
+// when cancel is called or if the subscriber falls behind.
+func (h *Hub) Subscribe(name, lastID string) (events <-chan Event, cancel func()) {
+	h.Lock()
+	defer h.Unlock()
+
+	t := h.topic(name)
+	var replay []Event
+	if last, err := strconv.ParseUint(lastID, 10, 64); err == nil {
+		for _, ev := range t.buf {
+			if seq, _ := strconv.ParseUint(ev.ID, 10, 64); seq > last {
+				replay = append(replay, ev)
+			}
+		}
+	}
+
+	ch := make(chan Event, subscriberQueue+len(replay))
+	for _, ev := range replay {
+		ch <- ev
+	}
+	t.subs[ch] = true
+
+	return ch, func() {
+		h.Lock()
+		defer h.Unlock()
+		if t.subs[ch] {
+			delete(t.subs, ch)
+			close(ch)
+		}
+		// topics that were only subscribed to are dropped with their last
+		// subscriber
+		if t.seq == 0 && len(t.subs) == 0 && h.topics[name] == t {
+			delete(h.topics, name)
+		}
+	}
+}
+
+// ServeHTTP streams the events of the topic named by the topic routing
+// parameter.
+func (h *Hub) ServeHTTP(c context.Context, w http.ResponseWriter, r *http.Request) {
+	h.stream(c, w, r, c.Value("topic").(string))
+}
+
+// Topic returns a ContextHandler streaming the events of the named topic.
+func (h *Hub) Topic(name string) contextrouter.ContextHandler {
+	return contextrouter.ContextHandlerFunc(func(c context.Context, w http.ResponseWriter, r *http.Request) {
+		h.stream(c, w, r, name)
+	})
+}
+
+// stream writes events of the topic to w until the root context is closed,
+// the client goes away or the subscriber falls behind.
+func (h *Hub) stream(c context.Context, w http.ResponseWriter, r *http.Request, name string) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	var gone <-chan bool
+	if cn, ok := w.(http.CloseNotifier); ok {
+		gone = cn.CloseNotify()
+	}
+
+	events, cancel := h.Subscribe(name, r.Header.Get("Last-Event-ID"))
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	var heartbeat <-chan time.Time
+	if h.heartbeat > 0 {
+		ticker := time.NewTicker(h.heartbeat)
+		defer ticker.Stop()
+		heartbeat = ticker.C
+	}
+
+	for {
+		select {
+		case <-c.Done():
+			return
+		case <-gone:
+			return
+		case <-heartbeat:
+			fmt.Fprint(w, ": heartbeat\n\n")
+			flusher.Flush()
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			writeEvent(w, ev)
+			flusher.Flush()
+		}
+	}
+}
+
+// writeEvent writes ev in the text/event-stream format
+func writeEvent(w http.ResponseWriter, ev Event) {
+	fmt.Fprintf(w, "id: %s\n", ev.ID)
+	if ev.Event != "" {
+		fmt.Fprintf(w, "event: %s\n", ev.Event)
+	}
+	for _, line := range strings.Split(ev.Data, "\n") {
+		fmt.Fprintf(w, "data: %s\n", line)
+	}
+	fmt.Fprint(w, "\n")
+}
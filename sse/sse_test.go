@@ -0,0 +1,208 @@
+package sse
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srinathh/mobilehtml5app/contextrouter"
+)
+
+// readEvents parses events from an event stream into the returned channel
+// which is closed when the stream ends. Heartbeat comments are reported as
+// events with the Event set to "heartbeat".
+func readEvents(t *testing.T, url, lastID string) chan Event {
+	req, _ := http.NewRequest("GET", url, nil)
+	if lastID != "" {
+		req.Header.Set("Last-Event-ID", lastID)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("could not connect to %s: %s", url, err)
+	}
+	if ct := res.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("want Content-Type text/event-stream got %s", ct)
+	}
+
+	events := make(chan Event, 100)
+	go func() {
+		defer res.Body.Close()
+		defer close(events)
+		s := bufio.NewScanner(res.Body)
+		ev := Event{}
+		var data []string
+		for s.Scan() {
+			line := s.Text()
+			switch {
+			case line == "":
+				if ev.ID != "" {
+					ev.Data = strings.Join(data, "\n")
+					events <- ev
+				}
+				ev, data = Event{}, nil
+			case strings.HasPrefix(line, ": heartbeat"):
+				events <- Event{Event: "heartbeat"}
+			case strings.HasPrefix(line, "id: "):
+				ev.ID = line[4:]
+			case strings.HasPrefix(line, "event: "):
+				ev.Event = line[7:]
+			case strings.HasPrefix(line, "data: "):
+				data = append(data, line[6:])
+			}
+		}
+	}()
+	return events
+}
+
+func nextEvent(t *testing.T, events chan Event) Event {
+	select {
+	case ev, ok := <-events:
+		if !ok {
+			t.Fatal("stream closed unexpectedly")
+		}
+		return ev
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	return Event{}
+}
+
+func TestHub(t *testing.T) {
+	hub := NewHub(3, time.Hour)
+	router := contextrouter.New()
+	router.Handle(contextrouter.GET, "/events/:topic", hub)
+	router.Handle(contextrouter.GET, "/fixed", hub.Topic("fixed"))
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	items := readEvents(t, ts.URL+"/events/items", "")
+	fixed := readEvents(t, ts.URL+"/fixed", "")
+	// wait for the subscriptions to be registered before publishing
+	time.Sleep(time.Millisecond * 50)
+
+	hub.Publish("items", "changed", "line1\nline2")
+	hub.Publish("fixed", "", "hello")
+	if _, err := hub.PublishJSON("items", "added", map[string]string{"Text": "Buy milk"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if ev := nextEvent(t, items); ev.ID != "1" || ev.Event != "changed" || ev.Data != "line1\nline2" {
+		t.Errorf("unexpected event %+v", ev)
+	}
+	if ev := nextEvent(t, items); ev.ID != "2" || ev.Event != "added" || ev.Data != `{"Text":"Buy milk"}` {
+		t.Errorf("unexpected event %+v", ev)
+	}
+	if ev := nextEvent(t, fixed); ev.ID != "1" || ev.Event != "" || ev.Data != "hello" {
+		t.Errorf("unexpected event %+v", ev)
+	}
+
+	router.Stop()
+	for _, events := range []chan Event{items, fixed} {
+		select {
+		case _, ok := <-events:
+			if ok {
+				t.Error("unexpected event after stop")
+			}
+		case <-time.After(time.Second):
+			t.Error("stream not closed on stop")
+		}
+	}
+}
+
+func TestReplay(t *testing.T) {
+	hub := NewHub(3, time.Hour)
+	router := contextrouter.New()
+	router.Handle(contextrouter.GET, "/events/:topic", hub)
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+	defer router.Stop()
+
+	for j := 0; j < 5; j++ {
+		hub.Publish("items", "changed", "")
+	}
+
+	// events 4 and 5 were missed and are still in the buffer
+	events := readEvents(t, ts.URL+"/events/items", "3")
+	for _, want := range []string{"4", "5"} {
+		if ev := nextEvent(t, events); ev.ID != want {
+			t.Errorf("want replayed event %s got %s", want, ev.ID)
+		}
+	}
+
+	// only the last 3 events are kept for replay
+	events = readEvents(t, ts.URL+"/events/items", "0")
+	for _, want := range []string{"3", "4", "5"} {
+		if ev := nextEvent(t, events); ev.ID != want {
+			t.Errorf("want replayed event %s got %s", want, ev.ID)
+		}
+	}
+}
+
+func TestHeartbeat(t *testing.T) {
+	hub := NewHub(3, time.Millisecond*20)
+	router := contextrouter.New()
+	router.Handle(contextrouter.GET, "/events/:topic", hub)
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+	defer router.Stop()
+
+	events := readEvents(t, ts.URL+"/events/items", "")
+	if ev := nextEvent(t, events); ev.Event != "heartbeat" {
+		t.Errorf("want heartbeat got %+v", ev)
+	}
+}
+
+func TestSlowSubscriber(t *testing.T) {
+	hub := NewHub(3, time.Hour)
+	events, cancel := hub.Subscribe("items", "")
+	defer cancel()
+	for j := 0; j < subscriberQueue+1; j++ {
+		hub.Publish("items", "changed", "")
+	}
+	n := 0
+	for range events {
+		n++
+	}
+	if n != subscriberQueue {
+		t.Errorf("want %d queued events before disconnect got %d", subscriberQueue, n)
+	}
+}
+
+func TestNoHeartbeat(t *testing.T) {
+	hub := NewHub(3, 0)
+	router := contextrouter.New()
+	router.Handle(contextrouter.GET, "/events/:topic", hub)
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+	defer router.Stop()
+
+	events := readEvents(t, ts.URL+"/events/items", "")
+	hub.Publish("items", "changed", "1")
+	if ev := nextEvent(t, events); ev.Event != "changed" {
+		t.Errorf("want changed event got %+v", ev)
+	}
+}
+
+func TestIdleTopics(t *testing.T) {
+	hub := NewHub(3, time.Hour)
+	_, cancel := hub.Subscribe("unknown", "")
+	_, cancel2 := hub.Subscribe("unknown", "")
+	cancel()
+	if len(hub.topics) != 1 {
+		t.Errorf("want the topic kept while subscribed got %d topics", len(hub.topics))
+	}
+	cancel2()
+	if len(hub.topics) != 0 {
+		t.Errorf("want topics never published to dropped got %d topics", len(hub.topics))
+	}
+
+	_, cancel = hub.Subscribe("items", "")
+	hub.Publish("items", "changed", "")
+	cancel()
+	if len(hub.topics) != 1 {
+		t.Errorf("want published topics kept for replay got %d topics", len(hub.topics))
+	}
+}